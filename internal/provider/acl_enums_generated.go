@@ -0,0 +1,11 @@
+// Code generated by genaclenums from github.com/confluentinc/ccloud-sdk-go-v2/kafkarest v0.3.0; DO NOT EDIT.
+
+package provider
+
+var sdkAclResourceTypes = []string{"UNKNOWN", "ANY", "TOPIC", "GROUP", "CLUSTER", "TRANSACTIONAL_ID", "DELEGATION_TOKEN"}
+
+var sdkAclPatternTypes = []string{"UNKNOWN", "ANY", "MATCH", "LITERAL", "PREFIXED"}
+
+var sdkAclOperations = []string{"UNKNOWN", "ANY", "ALL", "READ", "WRITE", "CREATE", "DELETE", "ALTER", "DESCRIBE", "CLUSTER_ACTION", "DESCRIBE_CONFIGS", "ALTER_CONFIGS", "IDEMPOTENT_WRITE"}
+
+var sdkAclPermissions = []string{"UNKNOWN", "ANY", "DENY", "ALLOW"}
@@ -0,0 +1,199 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	paramAuditLogFile = "audit_log_file"
+
+	auditLogResultSuccess = "success"
+	auditLogResultError   = "error"
+)
+
+// Appends a JSON line for every mutating HTTP request that create, update and delete operations send,
+// which can be kept as compliance evidence of an apply
+type auditLog struct {
+	mu   sync.Mutex
+	path string
+}
+
+type auditLogRecord struct {
+	Timestamp    string `json:"timestamp"`
+	ResourceType string `json:"resource_type"`
+	ResourceId   string `json:"resource_id"`
+	Operation    string `json:"operation"`
+	Method       string `json:"method"`
+	Endpoint     string `json:"endpoint"`
+	// The API Key or the identity pool the request was authenticated with, never a secret or a token
+	Principal  string `json:"principal"`
+	StatusCode int    `json:"status_code,omitempty"`
+	RequestId  string `json:"request_id,omitempty"`
+	Result     string `json:"result"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Collects the records of a single operation since its resource ID might only be known once it finishes
+type auditLogOperation struct {
+	mu      sync.Mutex
+	records []*auditLogRecord
+}
+
+type auditLogOperationContextKey struct{}
+
+func newAuditLog(path string) *auditLog {
+	if path == "" {
+		return nil
+	}
+	return &auditLog{path: path}
+}
+
+func (l *auditLog) write(records []*auditLogRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			_ = file.Close()
+			return err
+		}
+	}
+	return file.Close()
+}
+
+// Wraps create, update and delete functions of every resource so that the mutating requests they send are audited
+func addAuditLog(resources map[string]*schema.Resource) {
+	for resourceType, r := range resources {
+		if r.CreateContext != nil {
+			r.CreateContext = auditOperation(resourceType, "create", r.CreateContext)
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = auditOperation(resourceType, "update", r.UpdateContext)
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = auditOperation(resourceType, "delete", r.DeleteContext)
+		}
+	}
+}
+
+func auditOperation(resourceType, operationName string, operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client, ok := meta.(*Client)
+		if !ok || client.auditLog == nil {
+			return operation(ctx, d, meta)
+		}
+		// Delete operations clear the ID
+		resourceId := d.Id()
+		auditedOperation := &auditLogOperation{}
+		diags := operation(context.WithValue(ctx, auditLogOperationContextKey{}, auditedOperation), d, meta)
+		if d.Id() != "" {
+			resourceId = d.Id()
+		}
+
+		auditedOperation.mu.Lock()
+		records := auditedOperation.records
+		auditedOperation.mu.Unlock()
+		for _, record := range records {
+			record.ResourceType = resourceType
+			record.ResourceId = resourceId
+			record.Operation = operationName
+		}
+		if err := client.auditLog.write(records); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error writing the audit log %q: %s", client.auditLog.path, err))
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "The audit log couldn't be written",
+				Detail:   fmt.Sprintf("The requests sent to %s %q couldn't be written to %q: %s", operationName, resourceId, client.auditLog.path, err),
+			})
+		}
+		return diags
+	}
+}
+
+// Records every mutating HTTP request attempt that is sent while a create, update or delete operation is audited
+type auditLogRoundTripper struct {
+	Transport http.RoundTripper
+}
+
+func (t *auditLogRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var transport http.RoundTripper
+	if t.Transport != nil {
+		transport = t.Transport
+	} else {
+		transport = http.DefaultTransport
+	}
+
+	auditedOperation, _ := req.Context().Value(auditLogOperationContextKey{}).(*auditLogOperation)
+	if auditedOperation == nil || req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return transport.RoundTrip(req)
+	}
+	record := &auditLogRecord{
+		Timestamp: time.Now().UTC().Format(time.RFC3339Nano),
+		Method:    req.Method,
+		// Query parameters are omitted since they might contain page tokens
+		Endpoint:  fmt.Sprintf("%s://%s%s", req.URL.Scheme, req.URL.Host, req.URL.Path),
+		Principal: auditLogPrincipal(req),
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		record.Result = auditLogResultError
+		record.Error = err.Error()
+	} else {
+		record.StatusCode = resp.StatusCode
+		record.RequestId = resp.Header.Get(requestIdHeader)
+		record.Result = auditLogResultSuccess
+		if resp.StatusCode >= http.StatusBadRequest {
+			record.Result = auditLogResultError
+			record.Error = resp.Status
+		}
+	}
+	auditedOperation.mu.Lock()
+	auditedOperation.records = append(auditedOperation.records, record)
+	auditedOperation.mu.Unlock()
+
+	return resp, err
+}
+
+func auditLogPrincipal(req *http.Request) string {
+	if apiKey, _, ok := req.BasicAuth(); ok {
+		return apiKey
+	}
+	if identityPoolId := req.Header.Get(identityPoolIdHeader); identityPoolId != "" {
+		return identityPoolId
+	}
+	if strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") {
+		return "bearer token"
+	}
+	return ""
+}
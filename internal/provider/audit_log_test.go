@@ -0,0 +1,107 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	cmk "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAuditLog(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIdHeader, "req-123")
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer api.Close()
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	cmkCfg := cmk.NewConfiguration()
+	cmkCfg.Servers[0].URL = api.URL
+	cmkCfg.HTTPClient = createRetryableHttpClient(defaultHttpClientSettings())
+	client := &Client{auditLog: newAuditLog(path), cmkClient: cmk.NewAPIClient(cmkCfg), cloudApiKey: "ABCDEFGHIJKLMNOP", cloudApiSecret: "secret"}
+
+	// Requests are sent through the SDK client, the same way resources send them
+	send := func(ctx context.Context, method string) {
+		var err error
+		switch method {
+		case http.MethodPost:
+			_, _, err = client.cmkClient.ClustersCmkV2Api.CreateCmkV2Cluster(client.cmkApiContext(ctx)).CmkV2Cluster(cmk.CmkV2Cluster{}).Execute()
+		case http.MethodGet:
+			_, _, err = client.cmkClient.ClustersCmkV2Api.GetCmkV2Cluster(client.cmkApiContext(ctx), "lkc-abc123").Environment("env-abc123").Execute()
+		case http.MethodDelete:
+			// The server responds with 404 Not Found
+			_, _ = client.cmkClient.ClustersCmkV2Api.DeleteCmkV2Cluster(client.cmkApiContext(ctx), "lkc-abc123").Environment("env-abc123").Execute()
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	create := auditOperation("confluent_kafka_cluster", "create", func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		send(ctx, http.MethodPost)
+		// Read requests are not audited
+		send(ctx, http.MethodGet)
+		d.SetId("lkc-abc123")
+		return nil
+	})
+	d := kafkaResource().TestResourceData()
+	if diags := create(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	remove := auditOperation("confluent_kafka_cluster", "delete", func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		send(ctx, http.MethodDelete)
+		d.SetId("")
+		return nil
+	})
+	if diags := remove(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	// Requests outside of audited operations are not audited
+	send(context.Background(), http.MethodPost)
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 audit log records, got %d: %s", len(lines), content)
+	}
+	var created, deleted auditLogRecord
+	_ = json.Unmarshal([]byte(lines[0]), &created)
+	_ = json.Unmarshal([]byte(lines[1]), &deleted)
+	if created.ResourceType != "confluent_kafka_cluster" || created.ResourceId != "lkc-abc123" || created.Operation != "create" || created.Method != http.MethodPost ||
+		created.Principal != "ABCDEFGHIJKLMNOP" || created.Result != auditLogResultSuccess || created.RequestId != "req-123" ||
+		created.Endpoint != api.URL+"/cmk/v2/clusters" {
+		t.Fatalf("Unexpected audit log record of the create operation: %s", lines[0])
+	}
+	if deleted.ResourceId != "lkc-abc123" || deleted.Operation != "delete" || deleted.Result != auditLogResultError || deleted.StatusCode != http.StatusNotFound {
+		t.Fatalf("Unexpected audit log record of the delete operation: %s", lines[1])
+	}
+	if strings.Contains(string(content), "secret") {
+		t.Fatalf("Expected the audit log not to contain secrets: %s", content)
+	}
+}
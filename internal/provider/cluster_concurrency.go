@@ -0,0 +1,79 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"sync"
+)
+
+// Semaphores, one per Kafka cluster, that limit the number of concurrent HTTP requests to its Kafka REST endpoint,
+// since Terraform's default parallelism of 10 can exceed per-cluster Kafka REST API quotas
+type clusterSemaphores struct {
+	mu         sync.Mutex
+	limit      int
+	semaphores map[string]chan struct{}
+}
+
+// Returns semaphores that allow up to limit concurrent requests per Kafka cluster, or nil if limit is 0
+func createClusterSemaphores(limit int) *clusterSemaphores {
+	if limit <= 0 {
+		return nil
+	}
+	return &clusterSemaphores{
+		limit:      limit,
+		semaphores: make(map[string]chan struct{}),
+	}
+}
+
+// Returns the semaphore of the Kafka cluster, it's shared by all Kafka REST clients of the cluster
+func (s *clusterSemaphores) get(clusterId string) chan struct{} {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	semaphore, ok := s.semaphores[clusterId]
+	if !ok {
+		semaphore = make(chan struct{}, s.limit)
+		s.semaphores[clusterId] = semaphore
+	}
+	return semaphore
+}
+
+// Waits for a free slot of the semaphore before every HTTP request attempt, so that
+// requests waiting to be retried don't hold a slot
+type concurrencyLimitingRoundTripper struct {
+	Transport http.RoundTripper
+	semaphore chan struct{}
+}
+
+func (t *concurrencyLimitingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var transport http.RoundTripper
+	if t.Transport != nil {
+		transport = t.Transport
+	} else {
+		transport = http.DefaultTransport
+	}
+
+	select {
+	case t.semaphore <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-t.semaphore }()
+
+	return transport.RoundTrip(req)
+}
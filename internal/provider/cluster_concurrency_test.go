@@ -0,0 +1,76 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type countingRoundTripper struct {
+	mu            sync.Mutex
+	inFlight      int
+	maxInFlight   int
+	requestsCount int
+}
+
+func (t *countingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.inFlight++
+	t.requestsCount++
+	if t.inFlight > t.maxInFlight {
+		t.maxInFlight = t.inFlight
+	}
+	t.mu.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	t.mu.Lock()
+	t.inFlight--
+	t.mu.Unlock()
+	return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("")), Request: req}, nil
+}
+
+func TestClusterSemaphores(t *testing.T) {
+	if semaphores := createClusterSemaphores(0); semaphores != nil || semaphores.get("lkc-abc123") != nil {
+		t.Fatalf("expected no semaphores when the limit is 0")
+	}
+	semaphores := createClusterSemaphores(2)
+	if semaphores.get("lkc-abc123") != semaphores.get("lkc-abc123") {
+		t.Fatalf("expected Kafka REST clients of the same Kafka cluster to share a semaphore")
+	}
+	if semaphores.get("lkc-abc123") == semaphores.get("lkc-xyz123") {
+		t.Fatalf("expected Kafka clusters to have separate semaphores")
+	}
+
+	transport := &countingRoundTripper{}
+	client := &http.Client{Transport: &concurrencyLimitingRoundTripper{Transport: transport, semaphore: semaphores.get("lkc-abc123")}}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if resp, err := client.Get("https://pkc-abc123.us-west-2.aws.confluent.cloud"); err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+	if transport.requestsCount != 10 || transport.maxInFlight > 2 {
+		t.Fatalf("expected 10 requests with at most 2 concurrent ones, got %d requests with %d concurrent ones", transport.requestsCount, transport.maxInFlight)
+	}
+}
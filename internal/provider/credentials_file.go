@@ -0,0 +1,127 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const defaultCredentialsProfile = "default"
+
+// Provider arguments that can be read from provider.credentials_file, mapped to whether they are API Keys or API Secrets
+var credentialsFileArguments = map[string]bool{
+	"cloud_api_key":                 true,
+	"cloud_api_secret":              true,
+	"kafka_id":                      false,
+	"kafka_api_key":                 true,
+	"kafka_api_secret":              true,
+	"kafka_rest_endpoint":           false,
+	"schema_registry_id":            false,
+	"schema_registry_api_key":       true,
+	"schema_registry_api_secret":    true,
+	"schema_registry_rest_endpoint": false,
+}
+
+// Minimum lengths of the API Keys and API Secrets of Confluent Cloud, Kafka API Keys and API Secrets are not
+// checked since the ones of self-managed Kafka REST Proxies and Confluent Platform clusters can be shorter
+var credentialsFileMinLengths = map[string]int{
+	"cloud_api_key":              minApiKeyLength,
+	"cloud_api_secret":           minApiSecretLength,
+	"schema_registry_api_key":    minApiKeyLength,
+	"schema_registry_api_secret": minApiSecretLength,
+}
+
+// Reads provider arguments from a JSON file (an object with string values) or an INI file,
+// in which case the arguments are read from the section of the given profile and from the top of the file
+func loadCredentialsFile(path, profile string) (map[string]string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading credentials file %q: %s", path, err)
+	}
+	var credentials map[string]string
+	if strings.EqualFold(filepath.Ext(path), ".json") || bytes.HasPrefix(bytes.TrimSpace(content), []byte("{")) {
+		if err := json.Unmarshal(content, &credentials); err != nil {
+			return nil, fmt.Errorf("error parsing credentials file %q as JSON: %s", path, err)
+		}
+	} else {
+		var isSectionFound bool
+		credentials, isSectionFound, err = parseIniSection(content, profile)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing credentials file %q as INI: %s", path, err)
+		}
+		// Files with credentials at the top only don't need a section for the default profile
+		if !isSectionFound && profile != defaultCredentialsProfile {
+			return nil, fmt.Errorf("profile %q not found in %s", profile, path)
+		}
+	}
+
+	for key, value := range credentials {
+		isCredential, ok := credentialsFileArguments[key]
+		if !ok {
+			return nil, fmt.Errorf("error reading credentials file %q: unexpected key %q, expected one of %v", path, key, credentialsFileKeys())
+		}
+		if isCredential {
+			if _, errs := validateCredential(credentialsFileMinLengths[key], true)(value, key); len(errs) > 0 {
+				return nil, fmt.Errorf("error reading credentials file %q: %s", path, errs[0])
+			}
+		}
+	}
+	return credentials, nil
+}
+
+// Returns the values from the top of the file and from the section, and whether the section was found
+func parseIniSection(content []byte, section string) (map[string]string, bool, error) {
+	values := make(map[string]string)
+	isSectionFound := false
+	currentSection := ""
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			currentSection = strings.TrimSpace(line[1 : len(line)-1])
+			if currentSection == section {
+				isSectionFound = true
+			}
+		default:
+			key, value, found := strings.Cut(line, "=")
+			if !found {
+				return nil, false, fmt.Errorf("line %d: expected a \"key = value\" pair or a [section]", lineNumber)
+			}
+			if currentSection == "" || currentSection == section {
+				values[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"`)
+			}
+		}
+	}
+	return values, isSectionFound, scanner.Err()
+}
+
+func credentialsFileKeys() []string {
+	keys := make([]string, 0, len(credentialsFileArguments))
+	for key := range credentialsFileArguments {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadCredentialsFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("error writing %s: %s", path, err)
+		}
+		return path
+	}
+	cloudApiKey := "ABCDEFGHIJKLMNOP"
+	cloudApiSecret := strings.Repeat("s", 64)
+
+	jsonPath := writeFile("credentials.json", fmt.Sprintf(`{"cloud_api_key": %q, "cloud_api_secret": %q}`, cloudApiKey, cloudApiSecret))
+	credentials, err := loadCredentialsFile(jsonPath, defaultCredentialsProfile)
+	expected := map[string]string{"cloud_api_key": cloudApiKey, "cloud_api_secret": cloudApiSecret}
+	if err != nil || !reflect.DeepEqual(credentials, expected) {
+		t.Fatalf("expected %v, got %v, %v", expected, credentials, err)
+	}
+
+	iniPath := writeFile("credentials", fmt.Sprintf(`# Confluent Cloud credentials
+cloud_api_key = %s
+cloud_api_secret = "%s"
+
+[default]
+kafka_id = lkc-abc123
+
+[staging]
+kafka_id = lkc-xyz123
+`, cloudApiKey, cloudApiSecret))
+	credentials, err = loadCredentialsFile(iniPath, "staging")
+	expected = map[string]string{"cloud_api_key": cloudApiKey, "cloud_api_secret": cloudApiSecret, "kafka_id": "lkc-xyz123"}
+	if err != nil || !reflect.DeepEqual(credentials, expected) {
+		t.Fatalf("expected %v, got %v, %v", expected, credentials, err)
+	}
+	if _, err := loadCredentialsFile(iniPath, "prod"); err == nil || !strings.Contains(err.Error(), `profile "prod" not found`) {
+		t.Fatalf("expected an error for a missing profile, got %v", err)
+	}
+	// The default profile doesn't need a section
+	topOnlyPath := writeFile("top_only", fmt.Sprintf("cloud_api_key = %s\ncloud_api_secret = %s\n", cloudApiKey, cloudApiSecret))
+	credentials, err = loadCredentialsFile(topOnlyPath, defaultCredentialsProfile)
+	expected = map[string]string{"cloud_api_key": cloudApiKey, "cloud_api_secret": cloudApiSecret}
+	if err != nil || !reflect.DeepEqual(credentials, expected) {
+		t.Fatalf("expected %v, got %v, %v", expected, credentials, err)
+	}
+
+	for name, content := range map[string]string{
+		"unknown_key.json":    `{"cloud_api_token": "foo"}`,
+		"whitespace_key.json": `{"cloud_api_key": "foo bar"}`,
+		"short_key.json":      `{"cloud_api_key": "foo"}`,
+		"short_secret.ini":    "cloud_api_secret = secret",
+		"invalid.json":        `{"cloud_api_key": 123}`,
+		"missing_separator":   "cloud_api_key",
+		"placeholder_key.ini": "cloud_api_key = <cloud_api_key>",
+	} {
+		if _, err := loadCredentialsFile(writeFile(name, content), defaultCredentialsProfile); err == nil {
+			t.Fatalf("expected an error for %s", name)
+		}
+	}
+	if _, err := loadCredentialsFile(filepath.Join(dir, "nonexistent"), defaultCredentialsProfile); err == nil {
+		t.Fatalf("expected an error for a missing file")
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"net/http"
+)
+
+const paramValidateCredentials = "validate_credentials"
+
+// Sends a lightweight authenticated request with the Cloud API Key and the Kafka API Key of the provider block
+// so that invalid credentials fail the configuration instead of an apply midway. Only responses that prove
+// the credentials are invalid fail it, since the API Keys might lack permissions for the requests.
+func validateCredentials(ctx context.Context, c *Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if c.cloudApiKey != "" && c.cloudApiSecret != "" {
+		_, resp, err := c.orgClient.EnvironmentsOrgV2Api.ListOrgV2Environments(c.orgApiContext(ctx)).PageSize(1).Execute()
+		if isInvalidCredentialsResponse(resp) {
+			diags = append(diags, invalidCredentialsError("Cloud API Key", c.cloudApiKey, "cloud_api_key", "cloud_api_secret", err))
+		} else if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Could not validate Cloud API Key %q: %s", c.cloudApiKey, createDescriptiveError(err)))
+		}
+	}
+	if c.isKafkaMetadataSet && c.kafkaClusterId != "" {
+		kafkaRestClient := c.kafkaRestClientFactory.CreateKafkaRestClient(c.kafkaRestEndpoint, c.kafkaClusterId, c.kafkaApiKey, c.kafkaApiSecret, true)
+		_, resp, err := kafkaRestClient.apiClient.ClusterV3Api.GetKafkaV3Cluster(kafkaRestClient.apiContext(ctx), c.kafkaClusterId)
+		if isInvalidCredentialsResponse(resp) {
+			diags = append(diags, invalidCredentialsError("Kafka API Key", c.kafkaApiKey, "kafka_api_key", "kafka_api_secret", err))
+		} else if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Could not validate Kafka API Key %q: %s", c.kafkaApiKey, createDescriptiveError(err)))
+		}
+	}
+	return diags
+}
+
+func isInvalidCredentialsResponse(resp *http.Response) bool {
+	return ResponseHasExpectedStatusCode(resp, http.StatusUnauthorized) || ResponseHasStatusForbiddenDueToInvalidAPIKey(resp)
+}
+
+func invalidCredentialsError(kind, apiKey, apiKeyAttribute, apiSecretAttribute string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Invalid %s %q", kind, apiKey),
+		Detail: fmt.Sprintf("The %s %q was rejected: %s. Check the %s and %s attributes in the provider block (or the environment variables they default to), "+
+			"or set %s = false to skip this check.", kind, apiKey, createDescriptiveError(err), apiKeyAttribute, apiSecretAttribute, paramValidateCredentials),
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	org "github.com/confluentinc/ccloud-sdk-go-v2/org/v2"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidateCredentials(t *testing.T) {
+	statusCode := http.StatusUnauthorized
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(`{"errors":[{"status":"401","detail":"Unauthorized"}]}`))
+	}))
+	defer server.Close()
+	orgCfg := org.NewConfiguration()
+	orgCfg.Servers[0].URL = server.URL
+	orgCfg.HTTPClient = createRetryableHttpClientWithExponentialBackoff()
+	client := &Client{orgClient: org.NewAPIClient(orgCfg), cloudApiKey: "ABCDEFGHIJKLMNOP", cloudApiSecret: "secret"}
+
+	if diags := validateCredentials(context.Background(), client); !diags.HasError() || !strings.Contains(diags[0].Summary, "ABCDEFGHIJKLMNOP") {
+		t.Fatalf("Expected an error for the rejected Cloud API Key, got %v", diags)
+	}
+	// Missing permissions don't prove the Cloud API Key is invalid
+	statusCode = http.StatusForbidden
+	if diags := validateCredentials(context.Background(), client); diags.HasError() {
+		t.Fatalf("Expected no error when the Cloud API Key lacks permissions, got %v", diags)
+	}
+}
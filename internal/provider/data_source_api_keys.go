@@ -0,0 +1,159 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	apikeys "github.com/confluentinc/ccloud-sdk-go-v2/apikeys/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"time"
+)
+
+const (
+	paramApiKeys            = "api_keys"
+	paramOwnerId            = "owner_id"
+	paramOwnerKind          = "owner_kind"
+	paramResourceId         = "resource_id"
+	paramResourceKind       = "resource_kind"
+	paramResourceApiVersion = "resource_api_version"
+	paramCreatedAt          = "created_at"
+
+	apiKeysDataSourceId = "api-keys"
+)
+
+func apiKeysDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: apiKeysDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramOwnerId: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only API Keys owned by this Service Account or User are returned (e.g., `sa-abc123`).",
+			},
+			paramResourceId: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only API Keys for this resource are returned (e.g., `lkc-abc123`, or `cloud` for Cloud API Keys).",
+			},
+			paramApiKeys: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The API Keys, without their secrets.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramDisplayName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramOwnerId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramOwnerKind: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramResourceId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramResourceKind: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramResourceApiVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramCreatedAt: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func apiKeysDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	ownerId := d.Get(paramOwnerId).(string)
+	resourceId := d.Get(paramResourceId).(string)
+	tflog.Debug(ctx, fmt.Sprintf("Reading API Keys with %q=%q, %q=%q", paramOwnerId, ownerId, paramResourceId, resourceId))
+
+	apiKeys, err := loadApiKeys(ctx, meta.(*Client))
+	if err != nil {
+		return diag.Errorf("error reading API Keys: %s", createDescriptiveError(err))
+	}
+	apiKeys = filterApiKeys(apiKeys, ownerId, resourceId)
+
+	result := make([]map[string]interface{}, len(apiKeys))
+	for i, apiKey := range apiKeys {
+		createdAt := ""
+		if apiKey.Metadata != nil && apiKey.Metadata.CreatedAt != nil {
+			createdAt = apiKey.Metadata.CreatedAt.Format(time.RFC3339)
+		}
+		spec := apiKey.GetSpec()
+		owner := spec.GetOwner()
+		resource := spec.GetResource()
+		// API Key Secrets are only returned when API Keys are created, so they're never exposed here
+		result[i] = map[string]interface{}{
+			paramId:                 apiKey.GetId(),
+			paramDisplayName:        spec.GetDisplayName(),
+			paramDescription:        spec.GetDescription(),
+			paramOwnerId:            owner.GetId(),
+			paramOwnerKind:          owner.GetKind(),
+			paramResourceId:         resource.GetId(),
+			paramResourceKind:       resource.GetKind(),
+			paramResourceApiVersion: resource.GetApiVersion(),
+			paramCreatedAt:          createdAt,
+		}
+	}
+	if err := d.Set(paramApiKeys, result); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", apiKeysDataSourceId, ownerId, resourceId))
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading %d API Keys with %q=%q, %q=%q", len(result), paramOwnerId, ownerId, paramResourceId, resourceId))
+
+	return nil
+}
+
+// Empty ownerId and resourceId match all API Keys
+func filterApiKeys(apiKeys []apikeys.IamV2ApiKey, ownerId, resourceId string) []apikeys.IamV2ApiKey {
+	filteredApiKeys := make([]apikeys.IamV2ApiKey, 0)
+	for _, apiKey := range apiKeys {
+		spec := apiKey.GetSpec()
+		if ownerId != "" && spec.Owner.GetId() != ownerId {
+			continue
+		}
+		if resourceId != "" && spec.Resource.GetId() != resourceId {
+			continue
+		}
+		filteredApiKeys = append(filteredApiKeys, apiKey)
+	}
+	return filteredApiKeys
+}
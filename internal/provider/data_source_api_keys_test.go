@@ -0,0 +1,44 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	apikeys "github.com/confluentinc/ccloud-sdk-go-v2/apikeys/v2"
+	"testing"
+)
+
+func TestFilterApiKeys(t *testing.T) {
+	newApiKey := func(id, ownerId, resourceId string) apikeys.IamV2ApiKey {
+		return apikeys.IamV2ApiKey{Id: apikeys.PtrString(id), Spec: &apikeys.IamV2ApiKeySpec{
+			Owner:    &apikeys.ObjectReference{Id: ownerId},
+			Resource: &apikeys.ObjectReference{Id: resourceId},
+		}}
+	}
+	apiKeys := []apikeys.IamV2ApiKey{
+		newApiKey("KEY1", "sa-abc123", "lkc-abc123"),
+		newApiKey("KEY2", "sa-abc123", "cloud"),
+		newApiKey("KEY3", "u-xyz456", "lkc-abc123"),
+		{Id: apikeys.PtrString("KEY4")},
+	}
+	if filtered := filterApiKeys(apiKeys, "", ""); len(filtered) != 4 {
+		t.Fatalf("Expected all API Keys without filters, got %v", filtered)
+	}
+	if filtered := filterApiKeys(apiKeys, "sa-abc123", ""); len(filtered) != 2 {
+		t.Fatalf("Expected 2 API Keys of sa-abc123, got %v", filtered)
+	}
+	if filtered := filterApiKeys(apiKeys, "sa-abc123", "lkc-abc123"); len(filtered) != 1 || filtered[0].GetId() != "KEY1" {
+		t.Fatalf("Expected KEY1, got %v", filtered)
+	}
+}
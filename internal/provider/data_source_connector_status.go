@@ -0,0 +1,160 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	connect "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	paramType     = "type"
+	paramWorkerId = "worker_id"
+	paramTrace    = "trace"
+	paramTasks    = "tasks"
+	paramState    = "state"
+	paramMessage  = "msg"
+	paramTaskId   = "task_id"
+)
+
+func connectorStatusDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: connectorStatusDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramDisplayName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Connector.",
+			},
+			paramEnvironment:  environmentDataSourceSchema(),
+			paramKafkaCluster: kafkaClusterBlockDataSourceSchema(),
+			paramType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the Connector, `sink` or `source`.",
+			},
+			paramStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the Connector.",
+			},
+			paramWorkerId: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The worker ID of the Connector.",
+			},
+			paramTrace: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The error trace of the Connector.",
+			},
+			paramTasks: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The statuses of the Connector's tasks.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramTaskId: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						paramState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramWorkerId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramMessage: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func connectorStatusDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	displayName := d.Get(paramDisplayName).(string)
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
+	tflog.Debug(ctx, fmt.Sprintf("Reading Connector %q status", displayName))
+
+	c := meta.(*Client)
+	connectorStatus, _, err := executeConnectorStatusCreate(c.connectApiContext(ctx), c, displayName, environmentId, clusterId)
+	if err != nil {
+		return diag.Errorf("error reading Connector %q status: %s", displayName, createDescriptiveError(err))
+	}
+	connectorStatusJson, err := json.Marshal(connectorStatus)
+	if err != nil {
+		return diag.Errorf("error reading Connector %q status: error marshaling %#v to json: %s", displayName, connectorStatus, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Connector %q status: %s", displayName, connectorStatusJson))
+
+	if _, err := setConnectorStatusAttributes(d, connectorStatus, environmentId, clusterId); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Connector %q status", displayName))
+
+	return nil
+}
+
+func setConnectorStatusAttributes(d *schema.ResourceData, connectorStatus connect.InlineResponse2001, environmentId, clusterId string) (*schema.ResourceData, error) {
+	if err := d.Set(paramDisplayName, connectorStatus.GetName()); err != nil {
+		return nil, err
+	}
+	if err := setStringAttributeInListBlockOfSizeOne(paramEnvironment, paramId, environmentId, d); err != nil {
+		return nil, err
+	}
+	if err := setStringAttributeInListBlockOfSizeOne(paramKafkaCluster, paramId, clusterId, d); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramType, connectorStatus.GetType()); err != nil {
+		return nil, err
+	}
+	connector := connectorStatus.GetConnector()
+	if err := d.Set(paramStatus, connector.GetState()); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramWorkerId, connector.GetWorkerId()); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramTrace, connector.GetTrace()); err != nil {
+		return nil, err
+	}
+	tasks := make([]map[string]interface{}, len(connectorStatus.GetTasks()))
+	for i, task := range connectorStatus.GetTasks() {
+		tasks[i] = map[string]interface{}{
+			paramTaskId:   int(task.GetId()),
+			paramState:    task.GetState(),
+			paramWorkerId: task.GetWorkerId(),
+			paramMessage:  task.GetMsg(),
+		}
+	}
+	if err := d.Set(paramTasks, tasks); err != nil {
+		return nil, err
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", environmentId, clusterId, connectorStatus.GetName()))
+	return d, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	connectorStatusDataSourceScenarioName = "confluent_connector_status Data Source Lifecycle"
+	connectorStatusDataSourceLabel        = "test_connector_status_data_source_label"
+	connectorStatusEnvironmentId          = "env-1j3m9j"
+	connectorStatusClusterId              = "lkc-vnwdjz"
+	connectorStatusDisplayName            = "test_connector"
+)
+
+var fullConnectorStatusDataSourceLabel = fmt.Sprintf("data.confluent_connector_status.%s", connectorStatusDataSourceLabel)
+
+func TestAccDataSourceConnectorStatus(t *testing.T) {
+	containerPort := "8080"
+	containerPortTcp := fmt.Sprintf("%s/tcp", containerPort)
+	ctx := context.Background()
+	listeningPort := wait.ForListeningPort(nat.Port(containerPortTcp))
+	req := testcontainers.ContainerRequest{
+		Image:        "rodolpheche/wiremock",
+		ExposedPorts: []string{containerPortTcp},
+		WaitingFor:   listeningPort,
+	}
+	wiremockContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+
+	require.NoError(t, err)
+
+	// nolint:errcheck
+	defer wiremockContainer.Terminate(ctx)
+
+	host, err := wiremockContainer.Host(ctx)
+	require.NoError(t, err)
+
+	wiremockHttpMappedPort, err := wiremockContainer.MappedPort(ctx, nat.Port(containerPort))
+	require.NoError(t, err)
+
+	mockServerUrl := fmt.Sprintf("http://%s:%s", host, wiremockHttpMappedPort.Port())
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readConnectorStatusResponse, _ := ioutil.ReadFile("../testdata/connector_status/read_connector_status.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors/%s/status", connectorStatusEnvironmentId, connectorStatusClusterId, connectorStatusDisplayName))).
+		InScenario(connectorStatusDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readConnectorStatusResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceConnectorStatusConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullConnectorStatusDataSourceLabel, "id", fmt.Sprintf("%s/%s/%s", connectorStatusEnvironmentId, connectorStatusClusterId, connectorStatusDisplayName)),
+					resource.TestCheckResourceAttr(fullConnectorStatusDataSourceLabel, "display_name", connectorStatusDisplayName),
+					resource.TestCheckResourceAttr(fullConnectorStatusDataSourceLabel, "type", "source"),
+					resource.TestCheckResourceAttr(fullConnectorStatusDataSourceLabel, "status", stateFailed),
+					resource.TestCheckResourceAttr(fullConnectorStatusDataSourceLabel, "worker_id", "test-connector"),
+					resource.TestCheckResourceAttr(fullConnectorStatusDataSourceLabel, "trace", "org.apache.kafka.connect.errors.ConnectException: Unable to connect to the database"),
+					resource.TestCheckResourceAttr(fullConnectorStatusDataSourceLabel, "tasks.#", "1"),
+					resource.TestCheckResourceAttr(fullConnectorStatusDataSourceLabel, "tasks.0.task_id", "0"),
+					resource.TestCheckResourceAttr(fullConnectorStatusDataSourceLabel, "tasks.0.state", stateFailed),
+					resource.TestCheckResourceAttr(fullConnectorStatusDataSourceLabel, "tasks.0.worker_id", "test-connector"),
+					resource.TestCheckResourceAttr(fullConnectorStatusDataSourceLabel, "tasks.0.msg", "Unable to connect to the database"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceConnectorStatusConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	data "confluent_connector_status" "%s" {
+	  display_name = "%s"
+	  environment {
+	    id = "%s"
+	  }
+	  kafka_cluster {
+	    id = "%s"
+	  }
+	}
+	`, mockServerUrl, connectorStatusDataSourceLabel, connectorStatusDisplayName, connectorStatusEnvironmentId, connectorStatusClusterId)
+}
@@ -0,0 +1,176 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"regexp"
+	"strings"
+)
+
+const (
+	paramOrganizationId          = "organization_id"
+	paramEnvironmentId           = "environment_id"
+	paramKafkaClusterId          = "kafka_cluster_id"
+	paramGroupId                 = "group_id"
+	paramTransactionalId         = "transactional_id"
+	paramSchemaRegistryClusterId = "schema_registry_cluster_id"
+	paramSubjectName             = "subject_name"
+
+	crnPrefix                      = "crn://confluent.cloud"
+	crnSchemaRegistrySegmentPrefix = "schema-registry="
+)
+
+// Resource names may end with a single '*' to match all resources with the given prefix
+var crnResourceNameRegex = regexp.MustCompile(`^[^/*=]+\*?$|^\*$`)
+
+func crnDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: crnDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramOrganizationId: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the Organization (e.g., `1111aaaa-11aa-11aa-11aa-111111aaaaaa`).",
+				ValidateFunc: validation.IsUUID,
+			},
+			paramEnvironmentId: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The ID of the Environment (e.g., `env-abc123`).",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^env-"), "the Environment ID must be of the form 'env-'"),
+			},
+			paramKafkaClusterId: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{paramEnvironmentId},
+				ConflictsWith: []string{paramSchemaRegistryClusterId},
+				Description:   "The ID of the Kafka cluster (e.g., `lkc-abc123`).",
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile("^lkc-"), "the Kafka cluster ID must be of the form 'lkc-'"),
+			},
+			paramTopicName: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{paramKafkaClusterId},
+				ConflictsWith: []string{paramGroupId, paramTransactionalId},
+				Description:   "The name of the Kafka topic, or a prefix of topic names followed by `*`.",
+				ValidateFunc:  validateCrnResourceName,
+			},
+			paramGroupId: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{paramKafkaClusterId},
+				ConflictsWith: []string{paramTopicName, paramTransactionalId},
+				Description:   "The ID of the consumer group, or a prefix of consumer group IDs followed by `*`.",
+				ValidateFunc:  validateCrnResourceName,
+			},
+			paramTransactionalId: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{paramKafkaClusterId},
+				ConflictsWith: []string{paramTopicName, paramGroupId},
+				Description:   "The transactional ID, or a prefix of transactional IDs followed by `*`.",
+				ValidateFunc:  validateCrnResourceName,
+			},
+			paramSchemaRegistryClusterId: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{paramEnvironmentId},
+				ConflictsWith: []string{paramKafkaClusterId},
+				Description:   "The ID of the Schema Registry cluster (e.g., `lsrc-abc123`).",
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile("^lsrc-"), "the Schema Registry cluster ID must be of the form 'lsrc-'"),
+			},
+			paramSubjectName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{paramSchemaRegistryClusterId},
+				Description:  "The name of the Schema Registry subject, or a prefix of subject names followed by `*`.",
+				ValidateFunc: validateCrnResourceName,
+			},
+			paramCrnPattern: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN pattern that can be used as the `crn_pattern` argument of `confluent_role_binding`.",
+			},
+		},
+	}
+}
+
+func validateCrnResourceName(i interface{}, k string) ([]string, []error) {
+	name, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if !crnResourceNameRegex.MatchString(name) {
+		return nil, []error{fmt.Errorf("%q must not contain '/' or '=' and may only end with a single '*', got %q", k, name)}
+	}
+	return nil, nil
+}
+
+func crnDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "Reading CRN")
+
+	crnPattern := buildCrnPattern(map[string]string{
+		paramOrganizationId:          d.Get(paramOrganizationId).(string),
+		paramEnvironmentId:           d.Get(paramEnvironmentId).(string),
+		paramKafkaClusterId:          d.Get(paramKafkaClusterId).(string),
+		paramTopicName:               d.Get(paramTopicName).(string),
+		paramGroupId:                 d.Get(paramGroupId).(string),
+		paramTransactionalId:         d.Get(paramTransactionalId).(string),
+		paramSchemaRegistryClusterId: d.Get(paramSchemaRegistryClusterId).(string),
+		paramSubjectName:             d.Get(paramSubjectName).(string),
+	})
+	if err := d.Set(paramCrnPattern, crnPattern); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	d.SetId(crnPattern)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading CRN %q", crnPattern))
+
+	return nil
+}
+
+// Builds a CRN pattern, for example,
+// crn://confluent.cloud/organization=./environment=env-abc123/cloud-cluster=lkc-abc123/kafka=lkc-abc123/topic=orders
+// crn://confluent.cloud/organization=./environment=env-abc123/schema-registry=lsrc-abc123/subject=orders-value
+// Empty attributes are skipped, the schema makes sure the required parent segments are set.
+func buildCrnPattern(attributes map[string]string) string {
+	var crnPattern strings.Builder
+	crnPattern.WriteString(crnPrefix)
+	crnPattern.WriteString(crnOrgSuffix + attributes[paramOrganizationId])
+	if environmentId := attributes[paramEnvironmentId]; environmentId != "" {
+		crnPattern.WriteString(crnEnvironmentSuffix + environmentId)
+	}
+	if clusterId := attributes[paramKafkaClusterId]; clusterId != "" {
+		crnPattern.WriteString("/" + crnCloudClusterSegmentPrefix + clusterId + crnKafkaSuffix + clusterId)
+		for segment, param := range map[string]string{"topic": paramTopicName, "group": paramGroupId, "transactional-id": paramTransactionalId} {
+			if name := attributes[param]; name != "" {
+				crnPattern.WriteString(fmt.Sprintf("/%s=%s", segment, name))
+			}
+		}
+	}
+	if clusterId := attributes[paramSchemaRegistryClusterId]; clusterId != "" {
+		crnPattern.WriteString("/" + crnSchemaRegistrySegmentPrefix + clusterId)
+		if subjectName := attributes[paramSubjectName]; subjectName != "" {
+			crnPattern.WriteString("/subject=" + subjectName)
+		}
+	}
+	return crnPattern.String()
+}
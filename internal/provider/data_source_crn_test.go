@@ -0,0 +1,48 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestBuildCrnPattern(t *testing.T) {
+	const orgId = "1111aaaa-11aa-11aa-11aa-111111aaaaaa"
+	tests := []struct {
+		attributes map[string]string
+		expected   string
+	}{
+		{map[string]string{paramOrganizationId: orgId}, "crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa"},
+		{map[string]string{paramOrganizationId: orgId, paramEnvironmentId: "env-abc123"}, "crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-abc123"},
+		{map[string]string{paramOrganizationId: orgId, paramEnvironmentId: "env-abc123", paramKafkaClusterId: "lkc-abc123", paramTopicName: "orders-*"}, "crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-abc123/cloud-cluster=lkc-abc123/kafka=lkc-abc123/topic=orders-*"},
+		{map[string]string{paramOrganizationId: orgId, paramEnvironmentId: "env-abc123", paramKafkaClusterId: "lkc-abc123", paramTransactionalId: "tx"}, "crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-abc123/cloud-cluster=lkc-abc123/kafka=lkc-abc123/transactional-id=tx"},
+		{map[string]string{paramOrganizationId: orgId, paramEnvironmentId: "env-abc123", paramSchemaRegistryClusterId: "lsrc-abc123", paramSubjectName: "orders-value"}, "crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-abc123/schema-registry=lsrc-abc123/subject=orders-value"},
+	}
+	for _, test := range tests {
+		if actual := buildCrnPattern(test.attributes); actual != test.expected {
+			t.Fatalf("Unexpected CRN pattern for %v: expected %q, got %q", test.attributes, test.expected, actual)
+		}
+	}
+	for _, name := range []string{"orders", "orders-*", "*"} {
+		if _, errs := validateCrnResourceName(name, paramTopicName); len(errs) != 0 {
+			t.Fatalf("Expected %q to be a valid resource name, got %v", name, errs)
+		}
+	}
+	for _, name := range []string{"a/b", "a=b", "a*b", "**", ""} {
+		if _, errs := validateCrnResourceName(name, paramTopicName); len(errs) == 0 {
+			t.Fatalf("Expected %q to be an invalid resource name", name)
+		}
+	}
+}
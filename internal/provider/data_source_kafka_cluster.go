@@ -73,9 +73,11 @@ func kafkaDataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			paramBasicCluster:     basicClusterDataSourceSchema(),
-			paramStandardCluster:  standardClusterDataSourceSchema(),
-			paramDedicatedCluster: dedicatedClusterDataSourceSchema(),
+			paramBasicCluster:      basicClusterDataSourceSchema(),
+			paramStandardCluster:   standardClusterDataSourceSchema(),
+			paramDedicatedCluster:  dedicatedClusterDataSourceSchema(),
+			paramEnterpriseCluster: enterpriseClusterDataSourceSchema(),
+			paramFreightCluster:    freightClusterDataSourceSchema(),
 			paramBootStrapEndpoint: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -113,7 +115,7 @@ func kafkaDataSourceReadUsingDisplayName(ctx context.Context, d *schema.Resource
 	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Cluster %q=%q", paramDisplayName, displayName))
 
 	c := meta.(*Client)
-	kafkaClusters, err := loadKafkaClusters(ctx, c, environmentId)
+	kafkaClusters, configKinds, err := loadKafkaClusters(ctx, c, environmentId)
 	if err != nil {
 		return diag.Errorf("error reading Kafka Cluster %q: %s", displayName, createDescriptiveError(err))
 	}
@@ -123,7 +125,7 @@ func kafkaDataSourceReadUsingDisplayName(ctx context.Context, d *schema.Resource
 
 	for _, cluster := range kafkaClusters {
 		if cluster.Spec.GetDisplayName() == displayName {
-			if _, err := setKafkaClusterAttributes(d, cluster); err != nil {
+			if _, err := setKafkaClusterAttributes(d, cluster, configKinds); err != nil {
 				return diag.FromErr(createDescriptiveError(err))
 			}
 			return nil
@@ -137,7 +139,7 @@ func kafkaDataSourceReadUsingId(ctx context.Context, d *schema.ResourceData, met
 	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Cluster %q=%q", paramId, clusterId), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
 
 	c := meta.(*Client)
-	cluster, _, err := executeKafkaRead(c.cmkApiContext(ctx), c, environmentId, clusterId)
+	cluster, resp, err := executeKafkaRead(c.cmkApiContext(ctx), c, environmentId, clusterId)
 	if err != nil {
 		return diag.Errorf("error reading Kafka Cluster %q: %s", clusterId, createDescriptiveError(err))
 	}
@@ -147,7 +149,7 @@ func kafkaDataSourceReadUsingId(ctx context.Context, d *schema.ResourceData, met
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Fetched Kafka Cluster %q: %s", clusterId, clusterJson), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
 
-	if _, err := setKafkaClusterAttributes(d, cluster); err != nil {
+	if _, err := setKafkaClusterAttributes(d, cluster, extractKafkaClusterConfigKinds(resp)); err != nil {
 		return diag.FromErr(createDescriptiveError(err))
 	}
 	return nil
@@ -163,17 +165,21 @@ func orgHasMultipleKafkaClustersWithTargetDisplayName(clusters []v2.CmkV2Cluster
 	return numberOfClustersWithTargetDisplayName > 1
 }
 
-func loadKafkaClusters(ctx context.Context, c *Client, environmentId string) ([]v2.CmkV2Cluster, error) {
+func loadKafkaClusters(ctx context.Context, c *Client, environmentId string) ([]v2.CmkV2Cluster, kafkaClusterConfigKinds, error) {
 	clusters := make([]v2.CmkV2Cluster, 0)
+	configKinds := kafkaClusterConfigKinds{}
 
 	allClustersAreCollected := false
 	pageToken := ""
 	for !allClustersAreCollected {
-		clustersPageList, _, err := executeListKafkaClusters(ctx, c, environmentId, pageToken)
+		clustersPageList, resp, err := executeListKafkaClusters(ctx, c, environmentId, pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("error reading Kafka Clusters: %s", createDescriptiveError(err))
+			return nil, nil, fmt.Errorf("error reading Kafka Clusters: %s", createDescriptiveError(err))
 		}
 		clusters = append(clusters, clustersPageList.GetData()...)
+		for clusterId, configKind := range extractKafkaClusterConfigKinds(resp) {
+			configKinds[clusterId] = configKind
+		}
 
 		// nextPageUrlStringNullable is nil for the last page
 		nextPageUrlStringNullable := clustersPageList.GetMetadata().Next
@@ -185,14 +191,14 @@ func loadKafkaClusters(ctx context.Context, c *Client, environmentId string) ([]
 			} else {
 				pageToken, err = extractPageToken(nextPageUrlString)
 				if err != nil {
-					return nil, fmt.Errorf("error reading Kafka Clusters: %s", createDescriptiveError(err))
+					return nil, nil, fmt.Errorf("error reading Kafka Clusters: %s", createDescriptiveError(err))
 				}
 			}
 		} else {
 			allClustersAreCollected = true
 		}
 	}
-	return clusters, nil
+	return clusters, configKinds, nil
 }
 
 func executeListKafkaClusters(ctx context.Context, c *Client, environmentId, pageToken string) (v2.CmkV2ClusterList, *http.Response, error) {
@@ -214,6 +220,28 @@ func basicClusterDataSourceSchema() *schema.Schema {
 	}
 }
 
+func enterpriseClusterDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 0,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{},
+		},
+	}
+}
+
+func freightClusterDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 0,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{},
+		},
+	}
+}
+
 func standardClusterDataSourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
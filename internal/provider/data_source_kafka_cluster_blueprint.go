@@ -0,0 +1,266 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"sort"
+	"strings"
+)
+
+const (
+	paramTopics             = "topics"
+	paramTopicNamePrefix    = "topic_name_prefix"
+	paramMaxPartitionsCount = "max_partitions_count"
+)
+
+func kafkaClusterBlueprintDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: kafkaClusterBlueprintDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: optionalKafkaClusterBlockDataSourceSchema(),
+			paramRestEndpoint: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			paramCredentials: credentialsSchema(),
+			paramTopicNamePrefix: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only topics with names that start with the prefix are included, and the prefix is removed from topic names and from names of topic Kafka ACLs.",
+			},
+			paramMaxPartitionsCount: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of partitions of a topic in the blueprint, for example, to create smaller topics in lower environments.",
+			},
+			paramTopics: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The topics of the Kafka cluster, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramTopicName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramPartitionsCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						paramConfigs: {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			paramAcls: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Kafka ACLs of the Kafka cluster.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramResourceName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramPatternType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramPrincipal: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramHost: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramOperation: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramPermission: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kafkaClusterBlueprintDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	restEndpoint, err := extractRestEndpoint(client, d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Cluster Blueprint: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(client, d)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Cluster Blueprint: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(client, d, clusterId, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Cluster Blueprint: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := client.kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, client.isKafkaMetadataSet)
+	topicNamePrefix := d.Get(paramTopicNamePrefix).(string)
+	maxPartitionsCount := d.Get(paramMaxPartitionsCount).(int)
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Cluster Blueprint for Kafka Cluster %q", clusterId), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+
+	topics, err := loadKafkaClusterBlueprintTopics(ctx, d, kafkaRestClient, topicNamePrefix, maxPartitionsCount)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Cluster Blueprint for Kafka Cluster %q: %s", clusterId, createDescriptiveError(err))
+	}
+	acls, err := loadKafkaClusterBlueprintAcls(ctx, client, kafkaRestClient, topicNamePrefix)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Cluster Blueprint for Kafka Cluster %q: %s", clusterId, createDescriptiveError(err))
+	}
+
+	if err := d.Set(paramTopics, topics); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	if err := d.Set(paramAcls, acls); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	d.SetId(clusterId)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Kafka Cluster Blueprint for Kafka Cluster %q: %d topics and %d Kafka ACLs", clusterId, len(topics), len(acls)), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+
+	return nil
+}
+
+func loadKafkaClusterBlueprintTopics(ctx context.Context, d *schema.ResourceData, c *KafkaRestClient, topicNamePrefix string, maxPartitionsCount int) ([]map[string]interface{}, error) {
+	topicList, _, err := c.apiClient.TopicV3Api.ListKafkaV3Topics(c.apiContext(ctx), c.clusterId)
+	if err != nil {
+		return nil, fmt.Errorf("error listing Kafka Topics: %s", createDescriptiveError(err))
+	}
+	var topicNames []string
+	partitionsCounts := make(map[string]int32)
+	for _, topic := range topicList.Data {
+		if topic.IsInternal || !strings.HasPrefix(topic.TopicName, topicNamePrefix) {
+			continue
+		}
+		topicNames = append(topicNames, topic.TopicName)
+		partitionsCounts[topic.TopicName] = topic.PartitionsCount
+	}
+	sort.Strings(topicNames)
+
+	topics := make([]map[string]interface{}, 0, len(topicNames))
+	for _, topicName := range topicNames {
+		configs, err := loadTopicConfigs(ctx, d, c, topicName)
+		if err != nil {
+			return nil, err
+		}
+		topics = append(topics, map[string]interface{}{
+			paramTopicName:       strings.TrimPrefix(topicName, topicNamePrefix),
+			paramPartitionsCount: blueprintPartitionsCount(int(partitionsCounts[topicName]), maxPartitionsCount),
+			paramConfigs:         configs,
+		})
+	}
+	return topics, nil
+}
+
+func loadKafkaClusterBlueprintAcls(ctx context.Context, client *Client, c *KafkaRestClient, topicNamePrefix string) ([]map[string]interface{}, error) {
+	remoteAcls, _, err := executeKafkaAclRead(ctx, c, &kafkarestv3.GetKafkaV3AclsOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	// APIF-2038: Kafka REST API returns principals with integer IDs at the moment
+	principalsWithResourceId := make(map[string]string)
+	acls := make([]Acl, 0, len(remoteAcls.Data))
+	for _, remoteAcl := range remoteAcls.Data {
+		if !isKafkaAclInBlueprint(remoteAcl.ResourceType, remoteAcl.ResourceName, remoteAcl.PatternType, topicNamePrefix) {
+			continue
+		}
+		principal := remoteAcl.Principal
+		if principalWithIntegerIdRegex.MatchString(principal) {
+			principalWithResourceId, ok := principalsWithResourceId[principal]
+			if !ok {
+				principalWithResourceId, err = principalWithIntegerIdToPrincipalWithResourceId(client, principal)
+				if err != nil {
+					return nil, err
+				}
+				principalsWithResourceId[principal] = principalWithResourceId
+			}
+			principal = principalWithResourceId
+		}
+		resourceName := remoteAcl.ResourceName
+		if remoteAcl.ResourceType == kafkarestv3.ACLRESOURCETYPE_TOPIC {
+			resourceName = strings.TrimPrefix(resourceName, topicNamePrefix)
+		}
+		acls = append(acls, Acl{
+			ResourceType: remoteAcl.ResourceType,
+			ResourceName: resourceName,
+			PatternType:  remoteAcl.PatternType,
+			Principal:    principal,
+			Host:         normalizeAclHost(remoteAcl.Host),
+			Operation:    remoteAcl.Operation,
+			Permission:   remoteAcl.Permission,
+		})
+	}
+	sort.Slice(acls, func(i, j int) bool {
+		return createKafkaAclId("", acls[i]) < createKafkaAclId("", acls[j])
+	})
+
+	result := make([]map[string]interface{}, len(acls))
+	for i, acl := range acls {
+		result[i] = map[string]interface{}{
+			paramResourceType: string(acl.ResourceType),
+			paramResourceName: acl.ResourceName,
+			paramPatternType:  string(acl.PatternType),
+			paramPrincipal:    acl.Principal,
+			paramHost:         acl.Host,
+			paramOperation:    string(acl.Operation),
+			paramPermission:   string(acl.Permission),
+		}
+	}
+	return result, nil
+}
+
+// Returns false for LITERAL and PREFIXED TOPIC Kafka ACLs of topics that don't start with topicNamePrefix,
+// since those topics are not in the blueprint. The "*" wildcard applies to every topic, so it's kept.
+func isKafkaAclInBlueprint(resourceType kafkarestv3.AclResourceType, resourceName string, patternType kafkarestv3.AclPatternType, topicNamePrefix string) bool {
+	if resourceType != kafkarestv3.ACLRESOURCETYPE_TOPIC || resourceName == "*" {
+		return true
+	}
+	if patternType != kafkarestv3.ACLPATTERNTYPE_LITERAL && patternType != kafkarestv3.ACLPATTERNTYPE_PREFIXED {
+		return true
+	}
+	return strings.HasPrefix(resourceName, topicNamePrefix)
+}
+
+// Returns partitionsCount capped by maxPartitionsCount (if it's set)
+func blueprintPartitionsCount(partitionsCount, maxPartitionsCount int) int {
+	if maxPartitionsCount > 0 && partitionsCount > maxPartitionsCount {
+		return maxPartitionsCount
+	}
+	return partitionsCount
+}
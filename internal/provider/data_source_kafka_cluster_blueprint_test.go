@@ -0,0 +1,185 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	clusterBlueprintDataSourceScenarioName = "confluent_kafka_cluster_blueprint Data Source Lifecycle"
+	clusterBlueprintDataSourceLabel        = "test_cluster_blueprint_data_source_label"
+	clusterBlueprintTopicNamePrefix        = "test_"
+	clusterBlueprintMaxPartitionsCount     = 2
+)
+
+var fullClusterBlueprintDataSourceLabel = fmt.Sprintf("data.confluent_kafka_cluster_blueprint.%s", clusterBlueprintDataSourceLabel)
+
+func TestAccDataSourceClusterBlueprint(t *testing.T) {
+	containerPort := "8080"
+	containerPortTcp := fmt.Sprintf("%s/tcp", containerPort)
+	ctx := context.Background()
+	listeningPort := wait.ForListeningPort(nat.Port(containerPortTcp))
+	req := testcontainers.ContainerRequest{
+		Image:        "rodolpheche/wiremock",
+		ExposedPorts: []string{containerPortTcp},
+		WaitingFor:   listeningPort,
+	}
+	wiremockContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+
+	require.NoError(t, err)
+
+	// nolint:errcheck
+	defer wiremockContainer.Terminate(ctx)
+
+	host, err := wiremockContainer.Host(ctx)
+	require.NoError(t, err)
+
+	wiremockHttpMappedPort, err := wiremockContainer.MappedPort(ctx, nat.Port(containerPort))
+	require.NoError(t, err)
+
+	mockServerUrl := fmt.Sprintf("http://%s:%s", host, wiremockHttpMappedPort.Port())
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readServiceAccountsResponse, _ := ioutil.ReadFile("../testdata/kafka_cluster_blueprint/read_service_accounts.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readServiceAccountsPath)).
+		InScenario(clusterBlueprintDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readServiceAccountsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	listTopicsResponse, _ := ioutil.ReadFile("../testdata/kafka_cluster_blueprint/list_kafka_topics.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(createKafkaTopicPath)).
+		InScenario(clusterBlueprintDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(listTopicsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readTopicConfigResponse, _ := ioutil.ReadFile("../testdata/kafka_cluster_blueprint/read_kafka_topic_config.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readKafkaTopicConfigPath)).
+		InScenario(clusterBlueprintDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readTopicConfigResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	listAclsResponse, _ := ioutil.ReadFile("../testdata/kafka_cluster_blueprint/list_kafka_acls.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(createKafkaAclPath)).
+		InScenario(clusterBlueprintDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(listAclsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceClusterBlueprintConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "id", clusterId),
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "topics.#", "1"),
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "topics.0.topic_name", "topic_name"),
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "topics.0.partitions_count", fmt.Sprintf("%d", clusterBlueprintMaxPartitionsCount)),
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "topics.0.config.%", "2"),
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "topics.0.config.max.message.bytes", "12345"),
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "topics.0.config.retention.ms", "6789"),
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "acls.#", "2"),
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "acls.0.resource_type", "CLUSTER"),
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "acls.0.principal", aclPrincipalWithResourceId),
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "acls.1.resource_type", "TOPIC"),
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "acls.1.resource_name", "topic_name"),
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "acls.1.principal", aclPrincipalWithResourceId),
+					resource.TestCheckResourceAttr(fullClusterBlueprintDataSourceLabel, "acls.1.operation", "WRITE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceClusterBlueprintConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	data "confluent_kafka_cluster_blueprint" "%s" {
+	  kafka_cluster {
+	    id = "%s"
+	  }
+	  topic_name_prefix = "%s"
+	  max_partitions_count = %d
+	  rest_endpoint = "%s"
+	  credentials {
+	    key = "%s"
+	    secret = "%s"
+	  }
+	}
+	`, mockServerUrl, clusterBlueprintDataSourceLabel, clusterId, clusterBlueprintTopicNamePrefix, clusterBlueprintMaxPartitionsCount, mockServerUrl, kafkaApiKey, kafkaApiSecret)
+}
+
+func TestIsKafkaAclInBlueprint(t *testing.T) {
+	tests := []struct {
+		resourceType kafkarestv3.AclResourceType
+		resourceName string
+		patternType  kafkarestv3.AclPatternType
+		expected     bool
+	}{
+		{kafkarestv3.ACLRESOURCETYPE_TOPIC, "prod.orders", kafkarestv3.ACLPATTERNTYPE_LITERAL, true},
+		{kafkarestv3.ACLRESOURCETYPE_TOPIC, "prod.", kafkarestv3.ACLPATTERNTYPE_PREFIXED, true},
+		{kafkarestv3.ACLRESOURCETYPE_TOPIC, "staging.orders", kafkarestv3.ACLPATTERNTYPE_LITERAL, false},
+		{kafkarestv3.ACLRESOURCETYPE_TOPIC, "staging.", kafkarestv3.ACLPATTERNTYPE_PREFIXED, false},
+		{kafkarestv3.ACLRESOURCETYPE_TOPIC, "*", kafkarestv3.ACLPATTERNTYPE_LITERAL, true},
+		{kafkarestv3.ACLRESOURCETYPE_GROUP, "staging.consumers", kafkarestv3.ACLPATTERNTYPE_LITERAL, true},
+		{kafkarestv3.ACLRESOURCETYPE_CLUSTER, "kafka-cluster", kafkarestv3.ACLPATTERNTYPE_LITERAL, true},
+	}
+	for _, test := range tests {
+		if got := isKafkaAclInBlueprint(test.resourceType, test.resourceName, test.patternType, "prod."); got != test.expected {
+			t.Errorf("isKafkaAclInBlueprint(%q, %q, %q) = %t, expected %t", test.resourceType, test.resourceName, test.patternType, got, test.expected)
+		}
+	}
+}
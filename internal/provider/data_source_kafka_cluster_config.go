@@ -0,0 +1,135 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"sort"
+)
+
+const (
+	paramClusterConfigs = "configs"
+	paramConfigName     = "name"
+	paramConfigValue    = "value"
+	paramIsDefault      = "is_default"
+	paramIsReadOnly     = "is_read_only"
+	paramIsSensitive    = "is_sensitive"
+	paramConfigSource   = "source"
+)
+
+func kafkaClusterConfigDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: kafkaClusterConfigDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: optionalKafkaClusterBlockDataSourceSchema(),
+			paramRestEndpoint: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			paramCredentials: credentialsSchema(),
+			paramClusterConfigs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All cluster settings of the Kafka cluster, including default and read-only settings.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramConfigName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramConfigValue: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramIsDefault: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						paramIsReadOnly: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						paramIsSensitive: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						paramConfigSource: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kafkaClusterConfigDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Cluster Config: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient, err := createKafkaRestClientForClusterConfig(meta.(*Client), d, clusterId, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Cluster Config: %s", createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Cluster Config %q", clusterId), map[string]interface{}{kafkaClusterConfigLoggingKey: clusterId})
+
+	clusterConfigList, _, err := kafkaRestClient.apiClient.ConfigsV3Api.ListKafkaV3ClusterConfigs(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Cluster Config %q: %s", clusterId, createDescriptiveError(err))
+	}
+
+	configs := flattenClusterConfigs(clusterConfigList.Data)
+	if err := setStringAttributeInListBlockOfSizeOne(paramKafkaCluster, paramId, clusterId, d); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	if err := d.Set(paramClusterConfigs, configs); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	d.SetId(clusterId)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading %d settings of Kafka Cluster Config %q", len(configs), clusterId), map[string]interface{}{kafkaClusterConfigLoggingKey: clusterId})
+
+	return nil
+}
+
+// Returns cluster settings sorted by name, values of sensitive settings are not returned by Kafka REST API
+func flattenClusterConfigs(remoteConfigs []kafkarestv3.ClusterConfigData) []map[string]interface{} {
+	configs := make([]map[string]interface{}, len(remoteConfigs))
+	for i, remoteConfig := range remoteConfigs {
+		value := ""
+		if remoteConfig.Value != nil {
+			value = *remoteConfig.Value
+		}
+		configs[i] = map[string]interface{}{
+			paramConfigName:   remoteConfig.Name,
+			paramConfigValue:  value,
+			paramIsDefault:    remoteConfig.IsDefault,
+			paramIsReadOnly:   remoteConfig.IsReadOnly,
+			paramIsSensitive:  remoteConfig.IsSensitive,
+			paramConfigSource: string(remoteConfig.Source),
+		}
+	}
+	sort.Slice(configs, func(i, j int) bool {
+		return configs[i][paramConfigName].(string) < configs[j][paramConfigName].(string)
+	})
+	return configs
+}
@@ -0,0 +1,37 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"testing"
+)
+
+func TestFlattenClusterConfigs(t *testing.T) {
+	configs := flattenClusterConfigs([]kafkarestv3.ClusterConfigData{
+		{Name: "num.partitions", Value: ptr("6"), Source: kafkarestv3.CONFIGSOURCE_DYNAMIC_DEFAULT_BROKER_CONFIG},
+		{Name: "auto.create.topics.enable", Value: ptr("false"), IsDefault: true, IsReadOnly: true, Source: kafkarestv3.CONFIGSOURCE_DEFAULT_CONFIG},
+		{Name: "ssl.keystore.password", IsReadOnly: true, IsSensitive: true, Source: kafkarestv3.CONFIGSOURCE_STATIC_BROKER_CONFIG},
+	})
+	if len(configs) != 3 || configs[0][paramConfigName] != "auto.create.topics.enable" || configs[1][paramConfigName] != "num.partitions" {
+		t.Fatalf("Expected cluster settings sorted by name, got %v", configs)
+	}
+	if configs[0][paramIsDefault] != true || configs[0][paramConfigSource] != "DEFAULT_CONFIG" {
+		t.Fatalf("Unexpected default cluster setting %v", configs[0])
+	}
+	if configs[2][paramConfigValue] != "" || configs[2][paramIsSensitive] != true {
+		t.Fatalf("Expected an empty value for a sensitive cluster setting, got %v", configs[2])
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	v2 "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	paramClusters    = "clusters"
+	paramClusterType = "type"
+)
+
+func kafkaClustersDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: kafkaClustersDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramEnvironment: environmentDataSourceSchema(),
+			paramClusters: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Kafka clusters in the environment.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramDisplayName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramClusterType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramAvailability: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramCloud: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramBootStrapEndpoint: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramRestEndpoint: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramRbacCrn: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kafkaClustersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Clusters in Environment %q", environmentId))
+
+	c := meta.(*Client)
+	kafkaClusters, configKinds, err := loadKafkaClusters(ctx, c, environmentId)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Clusters in Environment %q: %s", environmentId, createDescriptiveError(err))
+	}
+
+	clusters := make([]map[string]interface{}, len(kafkaClusters))
+	for i, cluster := range kafkaClusters {
+		rbacCrn, err := clusterCrnToRbacClusterCrn(cluster.Metadata.GetResourceName())
+		if err != nil {
+			return diag.Errorf("error reading Kafka Cluster %q: could not construct %s", cluster.GetId(), paramRbacCrn)
+		}
+		clusters[i] = map[string]interface{}{
+			paramId:                cluster.GetId(),
+			paramDisplayName:       cluster.Spec.GetDisplayName(),
+			paramClusterType:       kafkaClusterType(cluster, configKinds),
+			paramAvailability:      cluster.Spec.GetAvailability(),
+			paramCloud:             cluster.Spec.GetCloud(),
+			paramRegion:            cluster.Spec.GetRegion(),
+			paramBootStrapEndpoint: cluster.Spec.GetKafkaBootstrapEndpoint(),
+			paramRestEndpoint:      cluster.Spec.GetHttpEndpoint(),
+			paramRbacCrn:           rbacCrn,
+		}
+	}
+	if err := d.Set(paramClusters, clusters); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	d.SetId(environmentId)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading %d Kafka Clusters in Environment %q", len(clusters), environmentId))
+
+	return nil
+}
+
+// Returns the type of the Kafka cluster, for example, "Basic" or "Dedicated"
+func kafkaClusterType(cluster v2.CmkV2Cluster, configKinds kafkaClusterConfigKinds) string {
+	if configKind, ok := configKinds[cluster.GetId()]; ok && configKind != "" {
+		return configKind
+	}
+	if cluster.Spec.Config.CmkV2Basic != nil {
+		return kafkaClusterTypeBasic
+	} else if cluster.Spec.Config.CmkV2Standard != nil {
+		return kafkaClusterTypeStandard
+	} else if cluster.Spec.Config.CmkV2Dedicated != nil {
+		return kafkaClusterTypeDedicated
+	}
+	return ""
+}
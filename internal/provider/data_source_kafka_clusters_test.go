@@ -0,0 +1,131 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	cmk "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	dataSourceKafkaClustersScenarioName = "confluent_kafka_clusters Data Source Lifecycle"
+	kafkaClustersDataSourceLabel        = "all"
+)
+
+var fullKafkaClustersDataSourceLabel = fmt.Sprintf("data.confluent_kafka_clusters.%s", kafkaClustersDataSourceLabel)
+
+func TestAccDataSourceClusters(t *testing.T) {
+	containerPort := "8080"
+	containerPortTcp := fmt.Sprintf("%s/tcp", containerPort)
+	ctx := context.Background()
+	listeningPort := wait.ForListeningPort(nat.Port(containerPortTcp))
+	req := testcontainers.ContainerRequest{
+		Image:        "rodolpheche/wiremock",
+		ExposedPorts: []string{containerPortTcp},
+		WaitingFor:   listeningPort,
+	}
+	wiremockContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+
+	require.NoError(t, err)
+
+	// nolint:errcheck
+	defer wiremockContainer.Terminate(ctx)
+
+	host, err := wiremockContainer.Host(ctx)
+	require.NoError(t, err)
+
+	wiremockHttpMappedPort, err := wiremockContainer.MappedPort(ctx, nat.Port(containerPort))
+	require.NoError(t, err)
+
+	mockServerUrl := fmt.Sprintf("http://%s:%s", host, wiremockHttpMappedPort.Port())
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readClustersResponse, _ := ioutil.ReadFile("../testdata/kafka/read_kafkas.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/cmk/v2/clusters")).
+		InScenario(dataSourceKafkaClustersScenarioName).
+		WithQueryParam("environment", wiremock.EqualTo(kafkaEnvId)).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readClustersResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceClustersConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullKafkaClustersDataSourceLabel, "id", kafkaEnvId),
+					resource.TestCheckResourceAttr(fullKafkaClustersDataSourceLabel, "clusters.#", "2"),
+					resource.TestCheckResourceAttr(fullKafkaClustersDataSourceLabel, "clusters.0.id", "lkc-19ynpv"),
+					resource.TestCheckResourceAttr(fullKafkaClustersDataSourceLabel, "clusters.0.display_name", "TestCluster"),
+					resource.TestCheckResourceAttr(fullKafkaClustersDataSourceLabel, "clusters.0.type", kafkaClusterTypeBasic),
+					resource.TestCheckResourceAttr(fullKafkaClustersDataSourceLabel, "clusters.0.availability", "SINGLE_ZONE"),
+					resource.TestCheckResourceAttr(fullKafkaClustersDataSourceLabel, "clusters.0.cloud", "GCP"),
+					resource.TestCheckResourceAttr(fullKafkaClustersDataSourceLabel, "clusters.0.region", "us-central1"),
+					resource.TestCheckResourceAttr(fullKafkaClustersDataSourceLabel, "clusters.0.rest_endpoint", "https://pkc-0wg55.us-central1.gcp.confluent.cloud:443"),
+					resource.TestCheckResourceAttr(fullKafkaClustersDataSourceLabel, "clusters.0.rbac_crn", "crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-1jrymj/cloud-cluster=lkc-19ynpv"),
+					resource.TestCheckResourceAttr(fullKafkaClustersDataSourceLabel, "clusters.1.id", "lkc-29ynpv"),
+					resource.TestCheckResourceAttr(fullKafkaClustersDataSourceLabel, "clusters.1.display_name", "TestCluster #2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceClustersConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+ 		endpoint = "%s"
+	}
+	data "confluent_kafka_clusters" "%s" {
+	  	environment {
+			id = "%s"
+	  	}
+	}
+	`, mockServerUrl, kafkaClustersDataSourceLabel, kafkaEnvId)
+}
+
+func TestKafkaClusterType(t *testing.T) {
+	basicCluster := cmk.CmkV2Cluster{Id: cmk.PtrString("lkc-abc123"), Spec: &cmk.CmkV2ClusterSpec{Config: &cmk.CmkV2ClusterSpecConfigOneOf{CmkV2Basic: cmk.NewCmkV2Basic(kafkaClusterTypeBasic)}}}
+	if clusterType := kafkaClusterType(basicCluster, kafkaClusterConfigKinds{}); clusterType != kafkaClusterTypeBasic {
+		t.Fatalf("Expected %q, got %q", kafkaClusterTypeBasic, clusterType)
+	}
+	if clusterType := kafkaClusterType(basicCluster, kafkaClusterConfigKinds{"lkc-abc123": kafkaClusterTypeEnterprise}); clusterType != kafkaClusterTypeEnterprise {
+		t.Fatalf("Expected %q, got %q", kafkaClusterTypeEnterprise, clusterType)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	paramAcls = "acls"
+)
+
+func kafkaPrincipalAclsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: kafkaPrincipalAclsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: optionalKafkaClusterBlockDataSourceSchema(),
+			paramPrincipal: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The principal to list Kafka ACLs for.",
+				ValidateFunc: validateKafkaAclPrincipal,
+			},
+			paramRestEndpoint: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			paramCredentials: credentialsSchema(),
+			paramAcls: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Kafka ACLs of the principal.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramResourceName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramPatternType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramHost: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramOperation: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramPermission: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kafkaPrincipalAclsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	restEndpoint, err := extractRestEndpoint(client, d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(client, d, clusterId, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := client.kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, client.isKafkaMetadataSet)
+	principal := d.Get(paramPrincipal).(string)
+	if err := validateKafkaAclPrincipalForCluster(client, principal); err != nil {
+		return diag.Errorf("error reading Kafka ACLs: %s", err)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka ACLs for %q", principal))
+
+	acls, err := loadKafkaAclsForPrincipal(ctx, client, kafkaRestClient, principal)
+	if err != nil {
+		return diag.Errorf("error reading Kafka ACLs for %q: %s", principal, createDescriptiveError(err))
+	}
+	result := make([]map[string]interface{}, len(acls))
+	for i, acl := range acls {
+		result[i] = map[string]interface{}{
+			paramResourceType: string(acl.ResourceType),
+			paramResourceName: acl.ResourceName,
+			paramPatternType:  string(acl.PatternType),
+			paramHost:         acl.Host,
+			paramOperation:    string(acl.Operation),
+			paramPermission:   string(acl.Permission),
+		}
+	}
+	if err := d.Set(paramAcls, result); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	d.SetId(createKafkaPrincipalAclPolicyId(clusterId, principal))
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading %d Kafka ACLs for %q", len(acls), principal))
+
+	return nil
+}
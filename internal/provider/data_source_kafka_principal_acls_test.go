@@ -0,0 +1,136 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+
+const (
+	principalAclsDataSourceScenarioName = "confluent_kafka_principal_acls Data Source Lifecycle"
+	principalAclsDataSourceLabel        = "test_principal_acls_data_source_label"
+)
+
+var fullPrincipalAclsDataSourceLabel = fmt.Sprintf("data.confluent_kafka_principal_acls.%s", principalAclsDataSourceLabel)
+
+func TestAccDataSourcePrincipalAcls(t *testing.T) {
+	containerPort := "8080"
+	containerPortTcp := fmt.Sprintf("%s/tcp", containerPort)
+	ctx := context.Background()
+	listeningPort := wait.ForListeningPort(nat.Port(containerPortTcp))
+	req := testcontainers.ContainerRequest{
+		Image:        "rodolpheche/wiremock",
+		ExposedPorts: []string{containerPortTcp},
+		WaitingFor:   listeningPort,
+	}
+	wiremockContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+
+	require.NoError(t, err)
+
+	// nolint:errcheck
+	defer wiremockContainer.Terminate(ctx)
+
+	host, err := wiremockContainer.Host(ctx)
+	require.NoError(t, err)
+
+	wiremockHttpMappedPort, err := wiremockContainer.MappedPort(ctx, nat.Port(containerPort))
+	require.NoError(t, err)
+
+	mockServerUrl := fmt.Sprintf("http://%s:%s", host, wiremockHttpMappedPort.Port())
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readServiceAccountsResponse, _ := ioutil.ReadFile("../testdata/kafka_principal_acls/read_service_accounts.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readServiceAccountsPath)).
+		InScenario(principalAclsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readServiceAccountsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readAclsResponse, _ := ioutil.ReadFile("../testdata/kafka_principal_acls/search_principal_kafka_acls.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(createKafkaAclPath)).
+		WithQueryParam("principal", wiremock.EqualTo(aclPrincipalWithIntegerId)).
+		InScenario(principalAclsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readAclsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourcePrincipalAclsConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullPrincipalAclsDataSourceLabel, "id", fmt.Sprintf("%s/%s", clusterId, aclPrincipalWithResourceId)),
+					resource.TestCheckResourceAttr(fullPrincipalAclsDataSourceLabel, "principal", aclPrincipalWithResourceId),
+					resource.TestCheckResourceAttr(fullPrincipalAclsDataSourceLabel, "acls.#", "1"),
+					resource.TestCheckResourceAttr(fullPrincipalAclsDataSourceLabel, "acls.0.resource_type", aclResourceType),
+					resource.TestCheckResourceAttr(fullPrincipalAclsDataSourceLabel, "acls.0.resource_name", aclResourceName),
+					resource.TestCheckResourceAttr(fullPrincipalAclsDataSourceLabel, "acls.0.pattern_type", aclPatternType),
+					resource.TestCheckResourceAttr(fullPrincipalAclsDataSourceLabel, "acls.0.host", aclHost),
+					resource.TestCheckResourceAttr(fullPrincipalAclsDataSourceLabel, "acls.0.operation", aclOperation),
+					resource.TestCheckResourceAttr(fullPrincipalAclsDataSourceLabel, "acls.0.permission", aclPermission),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourcePrincipalAclsConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	data "confluent_kafka_principal_acls" "%s" {
+	  kafka_cluster {
+	    id = "%s"
+	  }
+	  principal = "%s"
+	  rest_endpoint = "%s"
+	  credentials {
+	    key = "%s"
+	    secret = "%s"
+	  }
+	}
+	`, mockServerUrl, principalAclsDataSourceLabel, clusterId, aclPrincipalWithResourceId, mockServerUrl, kafkaApiKey, kafkaApiSecret)
+}
@@ -26,14 +26,14 @@ func kafkaTopicDataSource() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: kafkaTopicDataSourceRead,
 		Schema: map[string]*schema.Schema{
-			paramKafkaCluster: kafkaClusterBlockDataSourceSchema(),
+			paramKafkaCluster: optionalKafkaClusterBlockDataSourceSchema(),
 			paramTopicName: {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			paramRestEndpoint: {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			paramCredentials: credentialsSchema(),
 			paramPartitionsCount: {
@@ -54,12 +54,15 @@ func kafkaTopicDataSource() *schema.Resource {
 func kafkaTopicDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
 	if err != nil {
-		return diag.Errorf("error creating Kafka Topic: %s", createDescriptiveError(err))
+		return diag.Errorf("error reading Kafka Topic: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Topic: %s", createDescriptiveError(err))
 	}
-	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
-	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, clusterId, false)
 	if err != nil {
-		return diag.Errorf("error creating Kafka Topic: %s", createDescriptiveError(err))
+		return diag.Errorf("error reading Kafka Topic: %s", createDescriptiveError(err))
 	}
 	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaMetadataSet)
 	topicName := d.Get(paramTopicName).(string)
@@ -93,3 +96,12 @@ func kafkaClusterBlockDataSourceSchema() *schema.Schema {
 		MaxItems: 1,
 	}
 }
+
+// Same as kafkaClusterBlockDataSourceSchema but the block might be omitted when provider.kafka_id is set
+func optionalKafkaClusterBlockDataSourceSchema() *schema.Schema {
+	kafkaClusterBlock := kafkaClusterBlockDataSourceSchema()
+	kafkaClusterBlock.Required = false
+	kafkaClusterBlock.Optional = true
+	kafkaClusterBlock.Computed = true
+	return kafkaClusterBlock
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	paramVersion              = "version"
+	paramMinimumVersion       = "minimum_version"
+	paramSupportedApiFamilies = "supported_api_families"
+
+	providerInfoId = "provider-info"
+)
+
+// API families (and their versions) of Confluent Cloud APIs the provider is built against
+var supportedApiFamilies = []string{"apikeys/v2", "cmk/v2", "connect/v1", "iam/v1", "iam/v2", "kafka/v3", "mds/v2", "networking/v1", "org/v2"}
+
+func providerInfoDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: providerInfoDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramMinimumVersion: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The minimum version of the provider required by the configuration (e.g., `1.1.0`).",
+			},
+			paramVersion: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the provider.",
+			},
+			paramSupportedApiFamilies: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The API families (and their versions) supported by the provider.",
+			},
+		},
+	}
+}
+
+func providerInfoDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "Reading Provider Info")
+
+	providerVersion := meta.(*Client).providerVersion
+	if minimumVersion := d.Get(paramMinimumVersion).(string); minimumVersion != "" {
+		if err := verifyMinimumProviderVersion(providerVersion, minimumVersion); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set(paramVersion, providerVersion); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	if err := d.Set(paramSupportedApiFamilies, supportedApiFamilies); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	d.SetId(providerInfoId)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Provider Info: version is %q", providerVersion))
+
+	return nil
+}
+
+func verifyMinimumProviderVersion(providerVersion, minimumVersion string) error {
+	requiredVersion, err := version.NewVersion(minimumVersion)
+	if err != nil {
+		return fmt.Errorf("error reading Provider Info: %q is not a valid version: %s", minimumVersion, err)
+	}
+	actualVersion, err := version.NewVersion(providerVersion)
+	if err != nil {
+		// Development builds don't have a version injected from linker flags
+		return nil
+	}
+	if actualVersion.LessThan(requiredVersion) {
+		return fmt.Errorf("error reading Provider Info: the configuration requires version %s or newer of the Confluent Terraform Provider but version %s is used, "+
+			"upgrade the provider by running 'terraform init -upgrade'", requiredVersion, actualVersion)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	providerInfoDataSourceLabel = "test_provider_info_data_source_label"
+)
+
+var fullProviderInfoDataSourceLabel = fmt.Sprintf("data.confluent_provider_info.%s", providerInfoDataSourceLabel)
+
+func TestAccDataSourceProviderInfo(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceProviderInfoConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullProviderInfoDataSourceLabel, "id", providerInfoId),
+					resource.TestCheckResourceAttr(fullProviderInfoDataSourceLabel, "version", testVersion),
+					resource.TestCheckResourceAttr(fullProviderInfoDataSourceLabel, "supported_api_families.#", fmt.Sprintf("%d", len(supportedApiFamilies))),
+					resource.TestCheckResourceAttr(fullProviderInfoDataSourceLabel, "supported_api_families.0", "apikeys/v2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceProviderInfoConfig() string {
+	return fmt.Sprintf(`
+	data "confluent_provider_info" "%s" {
+	}
+	`, providerInfoDataSourceLabel)
+}
+
+func TestVerifyMinimumProviderVersion(t *testing.T) {
+	if err := verifyMinimumProviderVersion("1.1.0", "1.0.0"); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if err := verifyMinimumProviderVersion("1.1.0", "1.1.0"); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if err := verifyMinimumProviderVersion("1.1.0", "1.2.0"); err == nil {
+		t.Fatalf("expected an error for version 1.1.0 when version 1.2.0 is required")
+	}
+	if err := verifyMinimumProviderVersion(testVersion, "1.2.0"); err != nil {
+		t.Fatalf("expected no error for a development build, got %s", err)
+	}
+	if err := verifyMinimumProviderVersion("1.1.0", "latest"); err == nil {
+		t.Fatalf("expected an error for an invalid minimum version")
+	}
+}
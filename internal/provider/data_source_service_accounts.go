@@ -0,0 +1,107 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	v2 "github.com/confluentinc/ccloud-sdk-go-v2/iam/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
+)
+
+const (
+	paramServiceAccounts = "service_accounts"
+
+	serviceAccountsDataSourceId = "service-accounts"
+)
+
+func serviceAccountsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: serviceAccountsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramDisplayNamePrefix: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only Service Accounts whose display names start with this prefix are returned.",
+			},
+			paramServiceAccounts: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Service Accounts in the organization.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramDisplayName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func serviceAccountsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	displayNamePrefix := d.Get(paramDisplayNamePrefix).(string)
+	tflog.Debug(ctx, fmt.Sprintf("Reading Service Accounts with %q=%q", paramDisplayNamePrefix, displayNamePrefix))
+
+	serviceAccounts, err := loadServiceAccounts(ctx, meta.(*Client))
+	if err != nil {
+		return diag.Errorf("error reading Service Accounts: %s", createDescriptiveError(err))
+	}
+	serviceAccounts = filterServiceAccountsByDisplayNamePrefix(serviceAccounts, displayNamePrefix)
+
+	result := make([]map[string]interface{}, len(serviceAccounts))
+	for i, serviceAccount := range serviceAccounts {
+		result[i] = map[string]interface{}{
+			paramId:          serviceAccount.GetId(),
+			paramDisplayName: serviceAccount.GetDisplayName(),
+			paramDescription: serviceAccount.GetDescription(),
+		}
+	}
+	if err := d.Set(paramServiceAccounts, result); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	if displayNamePrefix != "" {
+		d.SetId(fmt.Sprintf("%s/%s", serviceAccountsDataSourceId, displayNamePrefix))
+	} else {
+		d.SetId(serviceAccountsDataSourceId)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading %d Service Accounts with %q=%q", len(result), paramDisplayNamePrefix, displayNamePrefix))
+
+	return nil
+}
+
+func filterServiceAccountsByDisplayNamePrefix(serviceAccounts []v2.IamV2ServiceAccount, displayNamePrefix string) []v2.IamV2ServiceAccount {
+	filteredServiceAccounts := make([]v2.IamV2ServiceAccount, 0)
+	for _, serviceAccount := range serviceAccounts {
+		if strings.HasPrefix(serviceAccount.GetDisplayName(), displayNamePrefix) {
+			filteredServiceAccounts = append(filteredServiceAccounts, serviceAccount)
+		}
+	}
+	return filteredServiceAccounts
+}
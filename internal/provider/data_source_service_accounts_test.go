@@ -0,0 +1,142 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	iam "github.com/confluentinc/ccloud-sdk-go-v2/iam/v2"
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	sasDataSourceScenarioName = "confluent_service_accounts Data Source Lifecycle"
+)
+
+func TestAccDataSourceServiceAccounts(t *testing.T) {
+	containerPort := "8080"
+	containerPortTcp := fmt.Sprintf("%s/tcp", containerPort)
+	ctx := context.Background()
+	listeningPort := wait.ForListeningPort(nat.Port(containerPortTcp))
+	req := testcontainers.ContainerRequest{
+		Image:        "rodolpheche/wiremock",
+		ExposedPorts: []string{containerPortTcp},
+		WaitingFor:   listeningPort,
+	}
+	wiremockContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+
+	require.NoError(t, err)
+
+	// nolint:errcheck
+	defer wiremockContainer.Terminate(ctx)
+
+	host, err := wiremockContainer.Host(ctx)
+	require.NoError(t, err)
+
+	wiremockHttpMappedPort, err := wiremockContainer.MappedPort(ctx, nat.Port(containerPort))
+	require.NoError(t, err)
+
+	mockServerUrl := fmt.Sprintf("http://%s:%s", host, wiremockHttpMappedPort.Port())
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readServiceAccountsPageOneResponse, _ := ioutil.ReadFile("../testdata/service_account/read_sas_page_1.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/iam/v2/service-accounts")).
+		WithQueryParam("page_size", wiremock.EqualTo(strconv.Itoa(listServiceAccountsPageSize))).
+		InScenario(sasDataSourceScenarioName).
+		WillReturn(
+			string(readServiceAccountsPageOneResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readServiceAccountsPageTwoResponse, _ := ioutil.ReadFile("../testdata/service_account/read_sas_page_2.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/iam/v2/service-accounts")).
+		WithQueryParam("page_size", wiremock.EqualTo(strconv.Itoa(listServiceAccountsPageSize))).
+		WithQueryParam("page_token", wiremock.EqualTo(saLastPagePageToken)).
+		InScenario(sasDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readServiceAccountsPageTwoResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	fullServiceAccountsDataSourceLabel := fmt.Sprintf("data.confluent_service_accounts.%s", saResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceServiceAccountsConfig(mockServerUrl, saResourceLabel, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullServiceAccountsDataSourceLabel, paramId, serviceAccountsDataSourceId),
+					resource.TestCheckResourceAttr(fullServiceAccountsDataSourceLabel, "service_accounts.#", "3"),
+					resource.TestCheckResourceAttr(fullServiceAccountsDataSourceLabel, "service_accounts.1.id", saId),
+					resource.TestCheckResourceAttr(fullServiceAccountsDataSourceLabel, "service_accounts.1.display_name", saDisplayName),
+				),
+			},
+			{
+				Config: testAccCheckDataSourceServiceAccountsConfig(mockServerUrl, saResourceLabel, "test_"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullServiceAccountsDataSourceLabel, paramId, fmt.Sprintf("%s/test_", serviceAccountsDataSourceId)),
+					resource.TestCheckResourceAttr(fullServiceAccountsDataSourceLabel, "service_accounts.#", "1"),
+					resource.TestCheckResourceAttr(fullServiceAccountsDataSourceLabel, "service_accounts.0.id", saId),
+					resource.TestCheckResourceAttr(fullServiceAccountsDataSourceLabel, "service_accounts.0.display_name", saDisplayName),
+					resource.TestCheckResourceAttr(fullServiceAccountsDataSourceLabel, "service_accounts.0.description", saDescription),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceServiceAccountsConfig(mockServerUrl, saResourceLabel, displayNamePrefix string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	data "confluent_service_accounts" "%s" {
+		display_name_prefix = "%s"
+	}
+	`, mockServerUrl, saResourceLabel, displayNamePrefix)
+}
+
+func TestFilterServiceAccountsByDisplayNamePrefix(t *testing.T) {
+	serviceAccounts := []iam.IamV2ServiceAccount{{DisplayName: iam.PtrString("app-orders")}, {DisplayName: iam.PtrString("ci")}, {DisplayName: iam.PtrString("app-payments")}}
+	if filtered := filterServiceAccountsByDisplayNamePrefix(serviceAccounts, "app-"); len(filtered) != 2 || filtered[1].GetDisplayName() != "app-payments" {
+		t.Fatalf("Unexpected Service Accounts %v", filtered)
+	}
+	if filtered := filterServiceAccountsByDisplayNamePrefix(serviceAccounts, ""); len(filtered) != 3 {
+		t.Fatalf("Expected all Service Accounts for an empty prefix, got %v", filtered)
+	}
+}
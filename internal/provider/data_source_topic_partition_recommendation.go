@@ -0,0 +1,141 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"math"
+	"time"
+)
+
+const (
+	paramWindow                          = "window"
+	paramTargetPartitionThroughput       = "target_partition_throughput_mbps"
+	paramPeakReceivedBytesPerSecond      = "peak_received_bytes_per_second"
+	paramPeakSentBytesPerSecond          = "peak_sent_bytes_per_second"
+	paramRecommendedPartitionsCount      = "recommended_partitions_count"
+	defaultPartitionRecommendationWindow = "168h"
+	bytesPerMegabyte                     = 1000 * 1000
+)
+
+func topicPartitionRecommendationDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: topicPartitionRecommendationDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: optionalKafkaClusterBlockDataSourceSchema(),
+			paramTopicName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The name of the topic, for example, `orders-1`.",
+			},
+			paramWindow: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultPartitionRecommendationWindow,
+				ValidateFunc: validatePositiveDuration,
+				Description:  "The period of time before now to look for the peak throughput in (e.g., `168h`).",
+			},
+			paramTargetPartitionThroughput: {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatAtLeast(0.001),
+				Description:  "The target throughput of a single partition in MB/s.",
+			},
+			paramPeakReceivedBytesPerSecond: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The peak hourly average of bytes per second produced to the topic.",
+			},
+			paramPeakSentBytesPerSecond: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The peak hourly average of bytes per second consumed from the topic.",
+			},
+			paramRecommendedPartitionsCount: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of partitions needed to serve the peak throughput at the target throughput per partition.",
+			},
+		},
+	}
+}
+
+func topicPartitionRecommendationDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	clusterId, err := extractKafkaClusterId(client, d)
+	if err != nil {
+		return diag.Errorf("error reading Topic Partition Recommendation: %s", createDescriptiveError(err))
+	}
+	topicName := d.Get(paramTopicName).(string)
+	// The window is validated to be a duration
+	window, _ := time.ParseDuration(d.Get(paramWindow).(string))
+	targetPartitionThroughput := d.Get(paramTargetPartitionThroughput).(float64)
+	tflog.Debug(ctx, fmt.Sprintf("Reading Topic Partition Recommendation for Kafka Topic %q", topicName), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+
+	end := time.Now().Truncate(time.Hour)
+	start := end.Add(-window)
+	peakReceivedBytesPerSecond, err := queryPeakBytesPerSecond(ctx, client, metricReceivedBytes, clusterId, topicName, start, end)
+	if err != nil {
+		return diag.Errorf("error reading Topic Partition Recommendation for Kafka Topic %q: %s", topicName, createDescriptiveError(err))
+	}
+	peakSentBytesPerSecond, err := queryPeakBytesPerSecond(ctx, client, metricSentBytes, clusterId, topicName, start, end)
+	if err != nil {
+		return diag.Errorf("error reading Topic Partition Recommendation for Kafka Topic %q: %s", topicName, createDescriptiveError(err))
+	}
+	recommendedPartitionsCount := recommendPartitionsCount(math.Max(peakReceivedBytesPerSecond, peakSentBytesPerSecond), targetPartitionThroughput)
+
+	if err := d.Set(paramPeakReceivedBytesPerSecond, peakReceivedBytesPerSecond); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	if err := d.Set(paramPeakSentBytesPerSecond, peakSentBytesPerSecond); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	if err := d.Set(paramRecommendedPartitionsCount, recommendedPartitionsCount); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	d.SetId(createKafkaTopicId(clusterId, topicName))
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Topic Partition Recommendation for Kafka Topic %q: %d partitions", topicName, recommendedPartitionsCount), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+
+	return nil
+}
+
+// Metrics are aggregated hourly so the peak is the maximum hourly average
+func queryPeakBytesPerSecond(ctx context.Context, client *Client, metric, clusterId, topicName string, start, end time.Time) (float64, error) {
+	values, err := client.queryTopicMetric(ctx, metric, clusterId, topicName, start, end)
+	if err != nil {
+		return 0, err
+	}
+	peakBytesPerHour := 0.0
+	for _, value := range values {
+		peakBytesPerHour = math.Max(peakBytesPerHour, value)
+	}
+	return peakBytesPerHour / time.Hour.Seconds(), nil
+}
+
+// Returns at least 1 partition
+func recommendPartitionsCount(peakBytesPerSecond, targetPartitionThroughputMbps float64) int {
+	partitionsCount := int(math.Ceil(peakBytesPerSecond / (targetPartitionThroughputMbps * bytesPerMegabyte)))
+	if partitionsCount < 1 {
+		return 1
+	}
+	return partitionsCount
+}
@@ -0,0 +1,154 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	topicPartitionRecommendationDataSourceScenarioName = "confluent_topic_partition_recommendation Data Source Lifecycle"
+	topicPartitionRecommendationDataSourceLabel        = "test_topic_partition_recommendation_data_source_label"
+	targetPartitionThroughputMbps                      = 5
+)
+
+var fullTopicPartitionRecommendationDataSourceLabel = fmt.Sprintf("data.confluent_topic_partition_recommendation.%s", topicPartitionRecommendationDataSourceLabel)
+
+func TestAccDataSourceTopicPartitionRecommendation(t *testing.T) {
+	containerPort := "8080"
+	containerPortTcp := fmt.Sprintf("%s/tcp", containerPort)
+	ctx := context.Background()
+	listeningPort := wait.ForListeningPort(nat.Port(containerPortTcp))
+	req := testcontainers.ContainerRequest{
+		Image:        "rodolpheche/wiremock",
+		ExposedPorts: []string{containerPortTcp},
+		WaitingFor:   listeningPort,
+	}
+	wiremockContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+
+	require.NoError(t, err)
+
+	// nolint:errcheck
+	defer wiremockContainer.Terminate(ctx)
+
+	host, err := wiremockContainer.Host(ctx)
+	require.NoError(t, err)
+
+	wiremockHttpMappedPort, err := wiremockContainer.MappedPort(ctx, nat.Port(containerPort))
+	require.NoError(t, err)
+
+	mockServerUrl := fmt.Sprintf("http://%s:%s", host, wiremockHttpMappedPort.Port())
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	queryReceivedBytesResponse, _ := ioutil.ReadFile("../testdata/topic_partition_recommendation/query_received_bytes.json")
+	queryReceivedBytesStub := wiremock.Post(wiremock.URLPathEqualTo(metricsQueryPath)).
+		WithBodyPattern(wiremock.Contains(metricReceivedBytes)).
+		InScenario(topicPartitionRecommendationDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(queryReceivedBytesResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(queryReceivedBytesStub)
+
+	querySentBytesResponse, _ := ioutil.ReadFile("../testdata/topic_partition_recommendation/query_sent_bytes.json")
+	querySentBytesStub := wiremock.Post(wiremock.URLPathEqualTo(metricsQueryPath)).
+		WithBodyPattern(wiremock.Contains(metricSentBytes)).
+		InScenario(topicPartitionRecommendationDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(querySentBytesResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(querySentBytesStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceTopicPartitionRecommendationConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullTopicPartitionRecommendationDataSourceLabel, "id", fmt.Sprintf("%s/%s", clusterId, topicName)),
+					resource.TestCheckResourceAttr(fullTopicPartitionRecommendationDataSourceLabel, "peak_received_bytes_per_second", "10000000"),
+					resource.TestCheckResourceAttr(fullTopicPartitionRecommendationDataSourceLabel, "peak_sent_bytes_per_second", "20000000"),
+					resource.TestCheckResourceAttr(fullTopicPartitionRecommendationDataSourceLabel, "recommended_partitions_count", "4"),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, queryReceivedBytesStub, fmt.Sprintf("POST %s", metricsQueryPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, querySentBytesStub, fmt.Sprintf("POST %s", metricsQueryPath), expectedCountOne)
+}
+
+func testAccCheckDataSourceTopicPartitionRecommendationConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+      metrics_endpoint = "%s"
+    }
+	data "confluent_topic_partition_recommendation" "%s" {
+	  kafka_cluster {
+	    id = "%s"
+	  }
+	  topic_name = "%s"
+	  window = "24h"
+	  target_partition_throughput_mbps = %d
+	}
+	`, mockServerUrl, mockServerUrl, topicPartitionRecommendationDataSourceLabel, clusterId, topicName, targetPartitionThroughputMbps)
+}
+
+func TestRecommendPartitionsCount(t *testing.T) {
+	tests := []struct {
+		peakBytesPerSecond            float64
+		targetPartitionThroughputMbps float64
+		expectedPartitionsCount       int
+	}{
+		{0, 10, 1},
+		{5000000, 10, 1},
+		{10000000, 10, 1},
+		{10000001, 10, 2},
+		{20000000, 5, 4},
+	}
+	for _, test := range tests {
+		if partitionsCount := recommendPartitionsCount(test.peakBytesPerSecond, test.targetPartitionThroughputMbps); partitionsCount != test.expectedPartitionsCount {
+			t.Fatalf("Expected %d partitions for %v B/s at %v MB/s per partition, got %d", test.expectedPartitionsCount, test.peakBytesPerSecond, test.targetPartitionThroughputMbps, partitionsCount)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	paramReadTopicNames  = "read_topic_names"
+	paramWriteTopicNames = "write_topic_names"
+	paramRoleBindings    = "role_bindings"
+
+	developerReadRoleName  = "DeveloperRead"
+	developerWriteRoleName = "DeveloperWrite"
+
+	crnCloudClusterSegmentPrefix = "cloud-cluster="
+)
+
+func topicRoleBindingsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: topicRoleBindingsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramPrincipal: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The principal User to bind the roles to.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^User:"), "the principal must start with 'User:'"),
+			},
+			paramRbacCrn: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The Confluent Resource Name of the Kafka cluster (the `rbac_crn` attribute of `confluent_kafka_cluster`).",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^crn://.+/cloud-cluster=lkc-[^/]+$"), "the CRN must be of the form 'crn://confluent.cloud/organization=<org ID>/environment=<env ID>/cloud-cluster=<Kafka cluster ID>'"),
+			},
+			paramReadTopicNames: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the topics the principal should be able to consume from.",
+			},
+			paramWriteTopicNames: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the topics the principal should be able to produce to.",
+			},
+			paramRoleBindings: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The minimal set of Role Bindings that grants the requested access.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramPrincipal: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramRoleName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramCrnPattern: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func topicRoleBindingsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	principal := d.Get(paramPrincipal).(string)
+	rbacCrn := d.Get(paramRbacCrn).(string)
+	tflog.Debug(ctx, fmt.Sprintf("Reading Topic Role Bindings for %q", principal))
+
+	readTopicNames := convertToStringSlice(d.Get(paramReadTopicNames).(*schema.Set).List())
+	writeTopicNames := convertToStringSlice(d.Get(paramWriteTopicNames).(*schema.Set).List())
+	crnPatterns, err := topicRoleBindingCrnPatterns(rbacCrn, readTopicNames, writeTopicNames)
+	if err != nil {
+		return diag.Errorf("error reading Topic Role Bindings: %s", createDescriptiveError(err))
+	}
+
+	roleBindings := make([]map[string]interface{}, len(crnPatterns))
+	for i, crnPattern := range crnPatterns {
+		roleBindings[i] = map[string]interface{}{
+			paramPrincipal:  principal,
+			paramRoleName:   crnPattern[0],
+			paramCrnPattern: crnPattern[1],
+		}
+	}
+	if err := d.Set(paramRoleBindings, roleBindings); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", principal, rbacCrn))
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading %d Topic Role Bindings for %q", len(roleBindings), principal))
+
+	return nil
+}
+
+// Returns sorted (role name, CRN pattern) pairs for the given topics of a Kafka cluster, for example,
+// (DeveloperRead, crn://confluent.cloud/organization=./environment=./cloud-cluster=lkc-abc123/kafka=lkc-abc123/topic=orders)
+func topicRoleBindingCrnPatterns(rbacCrn string, readTopicNames, writeTopicNames []string) ([][2]string, error) {
+	lastIndex := strings.LastIndex(rbacCrn, crnCloudClusterSegmentPrefix)
+	if lastIndex == -1 {
+		return nil, fmt.Errorf("could not find %s in %s", crnCloudClusterSegmentPrefix, rbacCrn)
+	}
+	clusterId := rbacCrn[lastIndex+len(crnCloudClusterSegmentPrefix):]
+
+	var crnPatterns [][2]string
+	for roleName, topicNames := range map[string][]string{developerReadRoleName: readTopicNames, developerWriteRoleName: writeTopicNames} {
+		for _, topicName := range topicNames {
+			crnPatterns = append(crnPatterns, [2]string{roleName, fmt.Sprintf("%s%s%s/topic=%s", rbacCrn, crnKafkaSuffix, clusterId, topicName)})
+		}
+	}
+	sort.Slice(crnPatterns, func(i, j int) bool {
+		if crnPatterns[i][0] != crnPatterns[j][0] {
+			return crnPatterns[i][0] < crnPatterns[j][0]
+		}
+		return crnPatterns[i][1] < crnPatterns[j][1]
+	})
+	return crnPatterns, nil
+}
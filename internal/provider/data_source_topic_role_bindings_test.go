@@ -0,0 +1,39 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTopicRoleBindingCrnPatterns(t *testing.T) {
+	rbacCrn := "crn://confluent.cloud/organization=foo/environment=env-abc123/cloud-cluster=lkc-abc123"
+	expected := [][2]string{
+		{developerReadRoleName, rbacCrn + "/kafka=lkc-abc123/topic=orders"},
+		{developerReadRoleName, rbacCrn + "/kafka=lkc-abc123/topic=payments"},
+		{developerWriteRoleName, rbacCrn + "/kafka=lkc-abc123/topic=orders"},
+	}
+	actual, err := topicRoleBindingCrnPatterns(rbacCrn, []string{"payments", "orders"}, []string{"orders"})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
+	}
+	if _, err := topicRoleBindingCrnPatterns("crn://confluent.cloud/organization=foo", nil, nil); err == nil {
+		t.Fatalf("expected an error for a CRN without a Kafka cluster")
+	}
+}
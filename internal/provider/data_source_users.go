@@ -0,0 +1,84 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	paramUsers = "users"
+
+	usersDataSourceId = "users"
+)
+
+func usersDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: usersDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramUsers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Users in the organization.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramId: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramEmail: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramFullName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func usersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "Reading Users")
+
+	users, err := loadUsers(ctx, meta.(*Client))
+	if err != nil {
+		return diag.Errorf("error reading Users: %s", createDescriptiveError(err))
+	}
+
+	result := make([]map[string]interface{}, len(users))
+	for i, user := range users {
+		result[i] = map[string]interface{}{
+			paramId:       user.GetId(),
+			paramEmail:    user.GetEmail(),
+			paramFullName: user.GetFullName(),
+		}
+	}
+	if err := d.Set(paramUsers, result); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	d.SetId(usersDataSourceId)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading %d Users", len(result)))
+
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	usersDataSourceScenarioName = "confluent_users Data Source Lifecycle"
+)
+
+func TestAccDataSourceUsers(t *testing.T) {
+	containerPort := "8080"
+	containerPortTcp := fmt.Sprintf("%s/tcp", containerPort)
+	ctx := context.Background()
+	listeningPort := wait.ForListeningPort(nat.Port(containerPortTcp))
+	req := testcontainers.ContainerRequest{
+		Image:        "rodolpheche/wiremock",
+		ExposedPorts: []string{containerPortTcp},
+		WaitingFor:   listeningPort,
+	}
+	wiremockContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+
+	require.NoError(t, err)
+
+	// nolint:errcheck
+	defer wiremockContainer.Terminate(ctx)
+
+	host, err := wiremockContainer.Host(ctx)
+	require.NoError(t, err)
+
+	wiremockHttpMappedPort, err := wiremockContainer.MappedPort(ctx, nat.Port(containerPort))
+	require.NoError(t, err)
+
+	mockServerUrl := fmt.Sprintf("http://%s:%s", host, wiremockHttpMappedPort.Port())
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readUsersPageOneResponse, _ := ioutil.ReadFile("../testdata/user/read_users_page_1.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/iam/v2/users")).
+		WithQueryParam("page_size", wiremock.EqualTo(strconv.Itoa(listUsersPageSize))).
+		InScenario(usersDataSourceScenarioName).
+		WillReturn(
+			string(readUsersPageOneResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readUsersPageTwoResponse, _ := ioutil.ReadFile("../testdata/user/read_users_page_2.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/iam/v2/users")).
+		WithQueryParam("page_size", wiremock.EqualTo(strconv.Itoa(listUsersPageSize))).
+		WithQueryParam("page_token", wiremock.EqualTo(userLastPagePageToken)).
+		InScenario(usersDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readUsersPageTwoResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	fullUsersDataSourceLabel := fmt.Sprintf("data.confluent_users.%s", userResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceUsersConfig(mockServerUrl, userResourceLabel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullUsersDataSourceLabel, paramId, usersDataSourceId),
+					resource.TestCheckResourceAttr(fullUsersDataSourceLabel, "users.#", "3"),
+					resource.TestCheckResourceAttr(fullUsersDataSourceLabel, "users.0.id", "u-1jjv21"),
+					resource.TestCheckResourceAttr(fullUsersDataSourceLabel, "users.0.email", "test1@gmail.com"),
+					resource.TestCheckResourceAttr(fullUsersDataSourceLabel, "users.2.id", userId),
+					resource.TestCheckResourceAttr(fullUsersDataSourceLabel, "users.2.email", userEmail),
+					resource.TestCheckResourceAttr(fullUsersDataSourceLabel, "users.2.full_name", userFullName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceUsersConfig(mockServerUrl, userResourceLabel string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	data "confluent_users" "%s" {
+	}
+	`, mockServerUrl, userResourceLabel)
+}
@@ -0,0 +1,210 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	redactedValue = "REDACTED"
+	// Longer bodies are truncated in the debug log
+	maxLoggedBodyLength = 64 * 1024
+)
+
+// Lowercase substrings of names of headers, query parameters, form fields and JSON fields whose values are redacted
+var sensitiveNameParts = []string{"authorization", "secret", "password", "token", "credential", "cookie", "api.key", "api_key", "apikey", "private_key", "client_key"}
+
+type skipDebugHttpBodiesKey struct{}
+
+// Returns a context whose requests are logged without their bodies, for requests whose bodies hold tokens
+// in fields that sensitiveNameParts doesn't match, such as "value" of GitHub Actions ID token responses
+func withoutDebugHttpBodies(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipDebugHttpBodiesKey{}, true)
+}
+
+// Logs method, URL, status and latency (and optionally bodies) of every HTTP request attempt
+// with values of sensitive headers, query parameters and body fields redacted
+type debugHttpRoundTripper struct {
+	Transport http.RoundTripper
+	logBodies bool
+}
+
+func (t *debugHttpRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var transport http.RoundTripper
+	if t.Transport != nil {
+		transport = t.Transport
+	} else {
+		transport = http.DefaultTransport
+	}
+
+	logBodies := t.logBodies && req.Context().Value(skipDebugHttpBodiesKey{}) == nil
+	fields := map[string]interface{}{
+		"http_method":          req.Method,
+		"http_url":             redactUrl(req.URL),
+		"http_request_headers": redactHeaders(req.Header),
+	}
+	if logBodies && req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		fields["http_request_body"] = redactBody(body, req.Header.Get("Content-Type"))
+	}
+
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	fields["http_latency"] = time.Since(start).Round(time.Millisecond).String()
+	if err != nil {
+		fields["http_error"] = err.Error()
+		tflog.Debug(req.Context(), fmt.Sprintf("HTTP request %s %s failed", req.Method, redactUrl(req.URL)), fields)
+		return resp, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+	fields["http_response_headers"] = redactHeaders(resp.Header)
+	if logBodies && resp.Body != nil {
+		body, readErr := ioutil.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(body), &errorReader{readErr}))
+		fields["http_response_body"] = redactBody(body, resp.Header.Get("Content-Type"))
+	}
+	tflog.Debug(req.Context(), fmt.Sprintf("HTTP request %s %s returned %d", req.Method, redactUrl(req.URL), resp.StatusCode), fields)
+
+	return resp, err
+}
+
+// Returns the error it was created with (if any) once the response body that has been read for logging is consumed
+type errorReader struct {
+	err error
+}
+
+func (r *errorReader) Read(_ []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	return 0, io.EOF
+}
+
+func isSensitiveName(name string) bool {
+	lowercaseName := strings.ToLower(name)
+	for _, part := range sensitiveNameParts {
+		if strings.Contains(lowercaseName, part) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactHeaders(headers http.Header) map[string]string {
+	redactedHeaders := make(map[string]string, len(headers))
+	for name, values := range headers {
+		if isSensitiveName(name) {
+			redactedHeaders[name] = redactedValue
+		} else {
+			redactedHeaders[name] = strings.Join(values, ", ")
+		}
+	}
+	return redactedHeaders
+}
+
+func redactUrl(u *url.URL) string {
+	redactedUrl := *u
+	if redactedUrl.User != nil {
+		redactedUrl.User = url.User(redactedValue)
+	}
+	if redactedUrl.RawQuery != "" {
+		redactedUrl.RawQuery = redactValues(redactedUrl.Query()).Encode()
+	}
+	return redactedUrl.String()
+}
+
+func redactValues(values url.Values) url.Values {
+	redactedValues := make(url.Values, len(values))
+	for name, value := range values {
+		if isSensitiveName(name) {
+			redactedValues[name] = []string{redactedValue}
+		} else {
+			redactedValues[name] = value
+		}
+	}
+	return redactedValues
+}
+
+// Redacts sensitive fields of JSON and form bodies, bodies of other content types are not logged
+// since sensitive values can't be detected in them
+func redactBody(body []byte, contentType string) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var redactedBody string
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return fmt.Sprintf("<%d bytes of a malformed form>", len(body))
+		}
+		redactedBody = redactValues(values).Encode()
+	case json.Valid(body):
+		var value interface{}
+		_ = json.Unmarshal(body, &value)
+		redactedJson, err := json.Marshal(redactJsonValue(value))
+		if err != nil {
+			return fmt.Sprintf("<%d bytes of JSON>", len(body))
+		}
+		redactedBody = string(redactedJson)
+	default:
+		return fmt.Sprintf("<%d bytes of %q>", len(body), contentType)
+	}
+	if len(redactedBody) > maxLoggedBodyLength {
+		return redactedBody[:maxLoggedBodyLength] + "...(truncated)"
+	}
+	return redactedBody
+}
+
+func redactJsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redactedObject := make(map[string]interface{}, len(v))
+		for name, fieldValue := range v {
+			if isSensitiveName(name) {
+				redactedObject[name] = redactedValue
+			} else {
+				redactedObject[name] = redactJsonValue(fieldValue)
+			}
+		}
+		return redactedObject
+	case []interface{}:
+		redactedArray := make([]interface{}, len(v))
+		for i, element := range v {
+			redactedArray[i] = redactJsonValue(element)
+		}
+		return redactedArray
+	default:
+		return v
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const paramDefaultEnvironmentId = "default_environment_id"
+
+// Wraps resources and data sources with an environment block so that provider.default_environment_id is used
+// when the block is omitted. Resources get it on plan, so the environment is stored in the state like a configured one.
+func addDefaultEnvironment(resources, dataSources map[string]*schema.Resource) {
+	for _, r := range resources {
+		if _, ok := r.Schema[paramEnvironment]; ok {
+			r.CustomizeDiff = setDefaultEnvironmentOnPlan(r.CustomizeDiff)
+		}
+	}
+	for _, r := range dataSources {
+		if _, ok := r.Schema[paramEnvironment]; ok && r.ReadContext != nil {
+			r.ReadContext = setDefaultEnvironmentOnRead(r.ReadContext)
+		}
+	}
+}
+
+func setDefaultEnvironmentOnPlan(customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		// Existing resources keep their environment even if provider.default_environment_id changes
+		if diff.Id() == "" && isEnvironmentBlockOmitted(diff.GetRawConfig()) {
+			if client, ok := meta.(*Client); ok && client != nil {
+				if client.defaultEnvironmentId == "" {
+					return missingEnvironmentError()
+				}
+				if err := diff.SetNew(paramEnvironment, defaultEnvironmentBlock(client.defaultEnvironmentId)); err != nil {
+					return err
+				}
+			}
+		}
+		if customizeDiff == nil {
+			return nil
+		}
+		return customizeDiff(ctx, diff, meta)
+	}
+}
+
+func setDefaultEnvironmentOnRead(read func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if len(d.Get(paramEnvironment).([]interface{})) == 0 {
+			client := meta.(*Client)
+			if client.defaultEnvironmentId == "" {
+				return diag.FromErr(missingEnvironmentError())
+			}
+			if err := d.Set(paramEnvironment, defaultEnvironmentBlock(client.defaultEnvironmentId)); err != nil {
+				return diag.FromErr(createDescriptiveError(err))
+			}
+		}
+		return read(ctx, d, meta)
+	}
+}
+
+// Reports whether the configuration has no environment block, an unknown one (e.g., a dynamic block) doesn't count
+func isEnvironmentBlockOmitted(config cty.Value) bool {
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+	environment := config.GetAttr(paramEnvironment)
+	return environment.IsNull() || (environment.IsKnown() && environment.LengthInt() == 0)
+}
+
+func defaultEnvironmentBlock(environmentId string) []interface{} {
+	return []interface{}{map[string]interface{}{
+		paramId: environmentId,
+	}}
+}
+
+func missingEnvironmentError() error {
+	return fmt.Errorf("%q block must be specified when %q is not set in the provider block", paramEnvironment, paramDefaultEnvironmentId)
+}
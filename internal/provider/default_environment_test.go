@@ -0,0 +1,66 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"testing"
+)
+
+func TestSetDefaultEnvironmentOnRead(t *testing.T) {
+	var readEnvironmentId string
+	read := setDefaultEnvironmentOnRead(func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		readEnvironmentId = extractStringValueFromBlock(d, paramEnvironment, paramId)
+		return nil
+	})
+	resourceSchema := map[string]*schema.Schema{paramEnvironment: environmentDataSourceSchema()}
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	if diags := read(context.Background(), d, &Client{}); !diags.HasError() {
+		t.Fatalf("expected an error when neither the environment block nor %s is set", paramDefaultEnvironmentId)
+	}
+	if diags := read(context.Background(), d, &Client{defaultEnvironmentId: "env-default"}); diags.HasError() || readEnvironmentId != "env-default" {
+		t.Fatalf("expected the default Environment ID to be read, got %q: %v", readEnvironmentId, diags)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		paramEnvironment: []interface{}{map[string]interface{}{paramId: "env-abc123"}},
+	})
+	if diags := read(context.Background(), d, &Client{defaultEnvironmentId: "env-default"}); diags.HasError() || readEnvironmentId != "env-abc123" {
+		t.Fatalf("expected the configured Environment ID to be read, got %q: %v", readEnvironmentId, diags)
+	}
+}
+
+func TestIsEnvironmentBlockOmitted(t *testing.T) {
+	environmentType := cty.List(cty.Object(map[string]cty.Type{paramId: cty.String}))
+	omitted := cty.ObjectVal(map[string]cty.Value{paramEnvironment: cty.ListValEmpty(environmentType.ElementType())})
+	configured := cty.ObjectVal(map[string]cty.Value{paramEnvironment: cty.ListVal([]cty.Value{
+		cty.ObjectVal(map[string]cty.Value{paramId: cty.UnknownVal(cty.String)}),
+	})})
+	dynamic := cty.ObjectVal(map[string]cty.Value{paramEnvironment: cty.UnknownVal(environmentType)})
+
+	if !isEnvironmentBlockOmitted(omitted) {
+		t.Fatalf("expected the environment block to be omitted")
+	}
+	if isEnvironmentBlockOmitted(configured) {
+		t.Fatalf("expected the environment block with an unknown ID not to be omitted")
+	}
+	if isEnvironmentBlockOmitted(dynamic) {
+		t.Fatalf("expected an unknown environment block not to be omitted")
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const paramEnableExperimentalResources = "enable_experimental_resources"
+
+// Resources and data sources that can only be created, updated or read once they are listed in
+// provider.enable_experimental_resources, so that big new subsystems can ship before they are stable.
+// Remove a resource from the list when it becomes generally available.
+var experimentalResources = []string{
+	// Deletes API Keys in bulk, and when exactly they are deleted might still change
+	"confluent_api_key_gc",
+}
+
+// Wraps functions of experimental resources and data sources so that they fail unless the resource
+// is enabled. Existing experimental resources can still be refreshed, planned and destroyed after they
+// are disabled, only creating and updating them fails.
+func addExperimentalResourceGates(resources, dataSources map[string]*schema.Resource) {
+	for _, resourceType := range experimentalResources {
+		if r, ok := resources[resourceType]; ok {
+			if r.CreateContext != nil {
+				r.CreateContext = requireExperimentalResource(resourceType, r.CreateContext)
+			}
+			if r.UpdateContext != nil {
+				r.UpdateContext = requireExperimentalResource(resourceType, r.UpdateContext)
+			}
+			r.CustomizeDiff = requireExperimentalResourceOnPlan(resourceType, r.CustomizeDiff)
+		}
+		if r, ok := dataSources[resourceType]; ok && r.ReadContext != nil {
+			r.ReadContext = requireExperimentalResource(resourceType, r.ReadContext)
+		}
+	}
+}
+
+func requireExperimentalResource(resourceType string, operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := checkExperimentalResourceIsEnabled(resourceType, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		return operation(ctx, d, meta)
+	}
+}
+
+func requireExperimentalResourceOnPlan(resourceType string, customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		// Only fail the plan of new resources, so that existing resources can still be refreshed
+		// and destroyed, and their updates fail on apply
+		if diff.Id() == "" {
+			if err := checkExperimentalResourceIsEnabled(resourceType, meta); err != nil {
+				return err
+			}
+		}
+		if customizeDiff == nil {
+			return nil
+		}
+		return customizeDiff(ctx, diff, meta)
+	}
+}
+
+func checkExperimentalResourceIsEnabled(resourceType string, meta interface{}) error {
+	client, ok := meta.(*Client)
+	// The provider is not configured yet, for example, during validation
+	if !ok || client == nil {
+		return nil
+	}
+	if !stringInSlice(resourceType, client.enabledExperimentalResources, false) {
+		return fmt.Errorf("%s is experimental and might change in backward-incompatible ways, "+
+			"add %q to %s in the provider block to use it", resourceType, resourceType, paramEnableExperimentalResources)
+	}
+	return nil
+}
+
+// Returns warnings for values of provider.enable_experimental_resources that are not experimental resources,
+// for example, because a resource has become generally available
+func validateEnabledExperimentalResources(enabledExperimentalResources []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, resourceType := range enabledExperimentalResources {
+		if !stringInSlice(resourceType, experimentalResources, false) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("%s is not an experimental resource", resourceType),
+				Detail:   fmt.Sprintf("%q can be removed from %s in the provider block: it is either generally available or unknown to this version of the provider.", resourceType, paramEnableExperimentalResources),
+			})
+		}
+	}
+	return diags
+}
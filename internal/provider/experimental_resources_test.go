@@ -0,0 +1,94 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"strings"
+	"testing"
+)
+
+func TestAddExperimentalResourceGates(t *testing.T) {
+	originalExperimentalResources := experimentalResources
+	experimentalResources = []string{"confluent_experimental"}
+	defer func() { experimentalResources = originalExperimentalResources }()
+
+	noop := func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics { return nil }
+	experimentalSchema := map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}}
+	resources := map[string]*schema.Resource{
+		"confluent_experimental": {Schema: experimentalSchema, CreateContext: noop, ReadContext: noop, UpdateContext: noop, DeleteContext: noop},
+		"confluent_stable":       {CreateContext: noop},
+	}
+	dataSources := map[string]*schema.Resource{
+		"confluent_experimental": {ReadContext: noop},
+	}
+	addExperimentalResourceGates(resources, dataSources)
+
+	disabledClient := &Client{}
+	enabledClient := &Client{enabledExperimentalResources: []string{"confluent_experimental"}}
+	if diags := resources["confluent_experimental"].CreateContext(context.Background(), nil, disabledClient); !diags.HasError() {
+		t.Fatalf("expected an error when creating a disabled experimental resource")
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "new"})
+	if _, err := resources["confluent_experimental"].Diff(context.Background(), nil, config, disabledClient); err == nil {
+		t.Fatalf("expected an error when planning to create a disabled experimental resource")
+	}
+	existingState := &terraform.InstanceState{ID: "experimental", Attributes: map[string]string{"id": "experimental", "name": "old"}}
+	if _, err := resources["confluent_experimental"].Diff(context.Background(), existingState, config, disabledClient); err != nil {
+		t.Fatalf("expected no error when planning an existing disabled experimental resource, got %v", err)
+	}
+	if diags := resources["confluent_experimental"].UpdateContext(context.Background(), nil, disabledClient); !diags.HasError() {
+		t.Fatalf("expected an error when updating a disabled experimental resource")
+	}
+	if diags := dataSources["confluent_experimental"].ReadContext(context.Background(), nil, disabledClient); !diags.HasError() {
+		t.Fatalf("expected an error when reading a disabled experimental data source")
+	}
+	if diags := resources["confluent_experimental"].DeleteContext(context.Background(), nil, disabledClient); diags.HasError() {
+		t.Fatalf("expected no error when deleting a disabled experimental resource, got %v", diags)
+	}
+	if diags := resources["confluent_experimental"].CreateContext(context.Background(), nil, enabledClient); diags.HasError() {
+		t.Fatalf("expected no error when creating an enabled experimental resource, got %v", diags)
+	}
+	if diags := resources["confluent_stable"].CreateContext(context.Background(), nil, disabledClient); diags.HasError() {
+		t.Fatalf("expected no error when creating a stable resource, got %v", diags)
+	}
+}
+
+func TestValidateEnabledExperimentalResources(t *testing.T) {
+	if diags := validateEnabledExperimentalResources(nil); len(diags) != 0 {
+		t.Fatalf("expected no warnings, got %v", diags)
+	}
+	diags := validateEnabledExperimentalResources([]string{"confluent_kafka_topic"})
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a warning for a resource that is not experimental, got %v", diags)
+	}
+	if diags := validateEnabledExperimentalResources([]string{"confluent_api_key_gc"}); len(diags) != 0 {
+		t.Fatalf("expected no warnings for an experimental resource, got %v", diags)
+	}
+}
+
+func TestProviderGatesExperimentalResources(t *testing.T) {
+	apiKeyGc := New(testVersion)().ResourcesMap["confluent_api_key_gc"]
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		paramDisplayNamePrefix: "ci-test-",
+		paramTtl:               "24h",
+	})
+	if _, err := apiKeyGc.Diff(context.Background(), nil, config, &Client{}); err == nil || !strings.Contains(err.Error(), paramEnableExperimentalResources) {
+		t.Fatalf("expected an error when planning confluent_api_key_gc without enabling it, got %v", err)
+	}
+}
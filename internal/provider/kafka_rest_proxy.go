@@ -0,0 +1,58 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	paramKafkaRestBasePath = "kafka_rest_base_path"
+
+	// The base path of Kafka REST API v3 that Confluent Cloud and Confluent Server use, and the SDK appends to the REST endpoint
+	defaultKafkaRestBasePath = "/kafka/v3"
+)
+
+// Replaces the base path of Kafka REST API v3 the SDK uses with the base path of a standalone Kafka REST Proxy (e.g., "/v3"),
+// so that self-managed Kafka clusters can be managed with the same Kafka REST client
+type kafkaRestBasePathRoundTripper struct {
+	Transport http.RoundTripper
+	basePath  string
+}
+
+func (t *kafkaRestBasePathRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var transport http.RoundTripper
+	if t.Transport != nil {
+		transport = t.Transport
+	} else {
+		transport = http.DefaultTransport
+	}
+
+	if strings.Contains(req.URL.Path, defaultKafkaRestBasePath+"/") {
+		// Round trippers must not modify the original request
+		req = req.Clone(req.Context())
+		req.URL.Path = strings.Replace(req.URL.Path, defaultKafkaRestBasePath+"/", t.basePath+"/", 1)
+		req.URL.RawPath = ""
+	}
+
+	return transport.RoundTrip(req)
+}
+
+// Reports whether Kafka REST calls go to self-managed Kafka clusters (Confluent Platform or a standalone Kafka REST Proxy),
+// whose principals are not Confluent Cloud accounts
+func (c *Client) isSelfManagedKafka() bool {
+	return c.mdsToken != nil || (c.kafkaRestBasePath != "" && c.kafkaRestBasePath != defaultKafkaRestBasePath)
+}
@@ -0,0 +1,52 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestKafkaRestBasePathRoundTripper(t *testing.T) {
+	var requestedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &kafkaRestBasePathRoundTripper{basePath: "/v3"}}
+	request, _ := http.NewRequest(http.MethodGet, server.URL+"/kafka/v3/clusters/cluster-1/topics", nil)
+	response, err := client.Do(request)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	_ = response.Body.Close()
+	if requestedPath != "/v3/clusters/cluster-1/topics" {
+		t.Fatalf("Expected the base path to be replaced, got %q", requestedPath)
+	}
+	if request.URL.Path != "/kafka/v3/clusters/cluster-1/topics" {
+		t.Fatalf("Expected the original request to be left unchanged, got %q", request.URL.Path)
+	}
+
+	// Principals of clusters behind a standalone Kafka REST Proxy are passed as is
+	if principal, err := principalWithResourceIdToPrincipalWithIntegerId(&Client{kafkaRestBasePath: "/v3"}, "User:alice"); err != nil || principal != "User:alice" {
+		t.Fatalf("Expected the principal to be passed as is, got %q: %v", principal, err)
+	}
+	if (&Client{kafkaRestBasePath: defaultKafkaRestBasePath}).isSelfManagedKafka() {
+		t.Fatalf("Expected the default base path not to be treated as a self-managed Kafka cluster")
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	paramMds         = "mds"
+	paramMdsEndpoint = "mds_endpoint"
+	paramMdsUsername = "mds_username"
+	paramMdsPassword = "mds_password"
+
+	mdsAuthenticatePath = "/security/1.0/authenticate"
+)
+
+type mdsTokenResponse struct {
+	AuthToken string `json:"auth_token"`
+	TokenType string `json:"token_type"`
+	ExpiresIn int64  `json:"expires_in"`
+}
+
+// Issues bearer tokens of Confluent Platform Metadata Service (MDS) that are used for Kafka REST calls instead of
+// Kafka API Keys, so that topics and ACLs of self-managed Confluent Platform clusters can be managed too.
+// MDS authenticates the provider with a username and password or with the client certificate of the HTTP client.
+// Tokens are cached and requested again shortly before they expire.
+type mdsToken struct {
+	mu              sync.Mutex
+	httpClient      *http.Client
+	authenticateUrl string
+	username        string
+	password        string
+	token           string
+	expiresAt       time.Time
+}
+
+func newMdsToken(httpClient *http.Client, endpoint, username, password string, isClientCertSet bool) (*mdsToken, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("%s must be set", paramMdsEndpoint)
+	}
+	if (username == "") != (password == "") {
+		return nil, fmt.Errorf("both %s and %s attributes should be set or not set at the same time", paramMdsUsername, paramMdsPassword)
+	}
+	if username == "" && !isClientCertSet {
+		return nil, fmt.Errorf("either %s and %s attributes, or kafka_rest_client_cert and kafka_rest_client_key attributes must be set", paramMdsUsername, paramMdsPassword)
+	}
+	return &mdsToken{
+		httpClient:      httpClient,
+		authenticateUrl: strings.TrimSuffix(endpoint, "/") + mdsAuthenticatePath,
+		username:        username,
+		password:        password,
+	}, nil
+}
+
+func (t *mdsToken) accessToken(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != "" && time.Now().Before(t.expiresAt) {
+		return t.token, nil
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Requesting MDS token from %q", t.authenticateUrl))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, t.authenticateUrl, nil)
+	if err != nil {
+		return "", err
+	}
+	if t.username != "" {
+		req.SetBasicAuth(t.username, t.password)
+	}
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error requesting MDS token from %q: %s", t.authenticateUrl, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("error requesting MDS token from %q: %s", t.authenticateUrl, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error requesting MDS token from %q: received a response with unexpected %d status code: %s", t.authenticateUrl, resp.StatusCode, body)
+	}
+	response := &mdsTokenResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return "", fmt.Errorf("error requesting MDS token from %q: %s", t.authenticateUrl, err)
+	}
+	if response.AuthToken == "" {
+		return "", fmt.Errorf("error requesting MDS token from %q: received a response without a token", t.authenticateUrl)
+	}
+	t.token = response.AuthToken
+	if response.ExpiresIn > 0 {
+		t.expiresAt = tokenExpiresAt(response.ExpiresIn)
+	} else {
+		t.expiresAt = time.Now().Add(defaultExternalTokenLifetime)
+	}
+	return t.token, nil
+}
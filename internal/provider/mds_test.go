@@ -0,0 +1,64 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMdsToken(t *testing.T) {
+	requestsCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestsCount++
+		if username, password, ok := r.BasicAuth(); r.URL.Path != mdsAuthenticatePath || !ok || username != "alice" || password != "alice-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"auth_token": "mds-token", "token_type": "Bearer", "expires_in": 3600}`))
+	}))
+	defer server.Close()
+
+	if _, err := newMdsToken(createRetryableHttpClientWithExponentialBackoff(), server.URL, "", "", false); err == nil {
+		t.Fatalf("Expected an error when neither a username nor a client certificate is set")
+	}
+	if _, err := newMdsToken(createRetryableHttpClientWithExponentialBackoff(), server.URL, "alice", "", false); err == nil {
+		t.Fatalf("Expected an error when the password is missing")
+	}
+	token, err := newMdsToken(createRetryableHttpClientWithExponentialBackoff(), server.URL+"/", "alice", "alice-secret", false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	for i := 0; i < 2; i++ {
+		if accessToken, err := token.accessToken(context.Background()); err != nil || accessToken != "mds-token" {
+			t.Fatalf("Unexpected MDS token %q: %v", accessToken, err)
+		}
+	}
+	if requestsCount != 1 {
+		t.Fatalf("Expected the MDS token to be cached, got %d requests", requestsCount)
+	}
+
+	invalidToken, _ := newMdsToken(createRetryableHttpClientWithExponentialBackoff(), server.URL, "alice", "wrong", false)
+	if _, err := invalidToken.accessToken(context.Background()); err == nil {
+		t.Fatalf("Expected an error when MDS rejects the credentials")
+	}
+
+	// Confluent Platform principals are passed as is
+	if principal, err := principalWithResourceIdToPrincipalWithIntegerId(&Client{mdsToken: token}, "User:alice"); err != nil || principal != "User:alice" {
+		t.Fatalf("Expected the principal to be passed as is, got %q: %v", principal, err)
+	}
+}
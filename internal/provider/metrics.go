@@ -0,0 +1,128 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	metricsQueryPath        = "/v2/metrics/cloud/query"
+	metricReceivedBytes     = "io.confluent.kafka.server/received_bytes"
+	metricSentBytes         = "io.confluent.kafka.server/sent_bytes"
+	metricsGranularityHour  = "PT1H"
+	metricsQueryResultLimit = 1000
+)
+
+// Metrics API (https://api.telemetry.confluent.cloud/docs) is not covered by Confluent Cloud SDKs so it's queried directly
+type MetricsClient struct {
+	httpClient *http.Client
+	endpoint   string
+	userAgent  string
+}
+
+type metricsQueryFilter struct {
+	Field   string               `json:"field,omitempty"`
+	Op      string               `json:"op"`
+	Value   string               `json:"value,omitempty"`
+	Filters []metricsQueryFilter `json:"filters,omitempty"`
+}
+
+type metricsQueryAggregation struct {
+	Metric string `json:"metric"`
+}
+
+type metricsQueryRequest struct {
+	Aggregations []metricsQueryAggregation `json:"aggregations"`
+	Filter       metricsQueryFilter        `json:"filter"`
+	Granularity  string                    `json:"granularity"`
+	Intervals    []string                  `json:"intervals"`
+	Limit        int                       `json:"limit"`
+}
+
+type metricsQueryResponse struct {
+	Data []struct {
+		Timestamp string  `json:"timestamp"`
+		Value     float64 `json:"value"`
+	} `json:"data"`
+}
+
+// Returns hourly values of the metric for the topic between start and end
+func (c *Client) queryTopicMetric(ctx context.Context, metric, clusterId, topicName string, start, end time.Time) ([]float64, error) {
+	query := metricsQueryRequest{
+		Aggregations: []metricsQueryAggregation{{Metric: metric}},
+		Filter: metricsQueryFilter{
+			Op: "AND",
+			Filters: []metricsQueryFilter{
+				{Field: "resource.kafka.id", Op: "EQ", Value: clusterId},
+				{Field: "metric.topic", Op: "EQ", Value: topicName},
+			},
+		},
+		Granularity: metricsGranularityHour,
+		Intervals:   []string{fmt.Sprintf("%s/%s", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))},
+		Limit:       metricsQueryResultLimit,
+	}
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(c.metricsClient.endpoint, "/")+metricsQueryPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.metricsClient.userAgent)
+	if c.oauthToken != nil {
+		token, err := c.cloudAccessToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if c.cloudApiKey != "" && c.cloudApiSecret != "" {
+		req.SetBasicAuth(c.cloudApiKey, c.cloudApiSecret)
+	} else {
+		tflog.Warn(ctx, "Could not find Cloud API Key")
+	}
+
+	resp, err := c.metricsClient.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error querying %q metric: received a response with unexpected %d status code: %s", metric, resp.StatusCode, responseBody)
+	}
+	response := metricsQueryResponse{}
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("error querying %q metric: %s", metric, err)
+	}
+	values := make([]float64, len(response.Data))
+	for i, point := range response.Data {
+		values[i] = point.Value
+	}
+	return values, nil
+}
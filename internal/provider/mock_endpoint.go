@@ -0,0 +1,58 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	paramMockEndpointMode  = "mock_endpoint_mode"
+	mockEndpointModeEnvVar = "CONFLUENT_MOCK_ENDPOINT_MODE"
+
+	// The default port of a standalone WireMock server
+	defaultMockEndpoint = "http://localhost:8080"
+
+	defaultEndpoint        = "https://api.confluent.cloud"
+	defaultMetricsEndpoint = "https://api.telemetry.confluent.cloud"
+)
+
+// Terraform validates the configuration before it configures the provider, so validators can't read
+// provider.mock_endpoint_mode and rely on the environment variable instead
+func isMockEndpointModeEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(mockEndpointModeEnvVar))
+	return enabled
+}
+
+// Skips validation of Confluent Cloud specific IDs (e.g., "lkc-") so that a mock server can return arbitrary IDs
+func skipInMockEndpointMode(validateFunc schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		if isMockEndpointModeEnabled() {
+			return nil, nil
+		}
+		return validateFunc(i, k)
+	}
+}
+
+// Points the endpoints that are left at their Confluent Cloud defaults to a local mock server
+func mockEndpoint(endpoint, defaultValue string) string {
+	if endpoint == defaultValue {
+		return defaultMockEndpoint
+	}
+	return endpoint
+}
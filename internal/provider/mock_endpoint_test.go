@@ -0,0 +1,44 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestMockEndpointMode(t *testing.T) {
+	if _, errs := validateKafkaClusterId("cluster-1", "id"); len(errs) == 0 {
+		t.Fatalf("Expected an error for a Kafka cluster ID that doesn't start with 'lkc-'")
+	}
+	t.Setenv(mockEndpointModeEnvVar, "true")
+	if _, errs := validateKafkaClusterId("cluster-1", "id"); len(errs) != 0 {
+		t.Fatalf("Expected the Kafka cluster ID validation to be skipped in mock endpoint mode, got %v", errs)
+	}
+
+	if endpoint := mockEndpoint(defaultEndpoint, defaultEndpoint); endpoint != defaultMockEndpoint {
+		t.Fatalf("Expected the default endpoint to be replaced with %q, got %q", defaultMockEndpoint, endpoint)
+	}
+	if endpoint := mockEndpoint("http://localhost:9090", defaultEndpoint); endpoint != "http://localhost:9090" {
+		t.Fatalf("Expected an explicitly set endpoint to be kept, got %q", endpoint)
+	}
+
+	client := &Client{isMockEndpointMode: true}
+	if principal, err := principalWithResourceIdToPrincipalWithIntegerId(client, "User:sa-abc123"); err != nil || principal != "User:sa-abc123" {
+		t.Fatalf("Expected the principal to be passed as is, got %q: %v", principal, err)
+	}
+	if principal, err := principalWithIntegerIdToPrincipalWithResourceId(client, "User:6789"); err != nil || principal != "User:6789" {
+		t.Fatalf("Expected the principal to be passed as is, got %q: %v", principal, err)
+	}
+}
@@ -0,0 +1,348 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	paramOAuth                       = "oauth"
+	paramOAuthIdentityPoolId         = "oauth_identity_pool_id"
+	paramOAuthExternalTokenUrl       = "oauth_external_token_url"
+	paramOAuthExternalClientId       = "oauth_external_client_id"
+	paramOAuthExternalClientSecret   = "oauth_external_client_secret"
+	paramOAuthExternalTokenScope     = "oauth_external_token_scope"
+	paramOAuthExternalAccessToken    = "oauth_external_access_token"
+	paramOAuthExternalTokenFile      = "oauth_external_token_file"
+	paramOAuthGitHubActionsAudience  = "oauth_github_actions_audience"
+	paramOAuthTfcWorkloadIdentity    = "oauth_tfc_workload_identity"
+	paramOAuthTfcWorkloadIdentityTag = "oauth_tfc_workload_identity_tag"
+
+	stsTokenPath                  = "/sts/v1/oauth2/token"
+	identityPoolIdHeader          = "Confluent-Identity-Pool-Id"
+	grantTypeClientCredentials    = "client_credentials"
+	grantTypeTokenExchange        = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenTypeJwt                  = "urn:ietf:params:oauth:token-type:jwt"
+	tokenTypeAccessToken          = "urn:ietf:params:oauth:token-type:access_token"
+	tokenExpirationSafetyInterval = 1 * time.Minute
+	// Tokens without an expiration time are read or requested again after it
+	defaultExternalTokenLifetime = 5 * time.Minute
+
+	// Set by GitHub Actions for jobs with "id-token: write" permission
+	gitHubActionsIdTokenRequestUrlEnvVar   = "ACTIONS_ID_TOKEN_REQUEST_URL"
+	gitHubActionsIdTokenRequestTokenEnvVar = "ACTIONS_ID_TOKEN_REQUEST_TOKEN"
+	// Set by HCP Terraform (Terraform Cloud) for runs of workspaces with TFC_WORKLOAD_IDENTITY_AUDIENCE variable,
+	// or with TFC_WORKLOAD_IDENTITY_AUDIENCE_<TAG> variables, in which case the tag is appended
+	tfcWorkloadIdentityTokenEnvVar = "TFC_WORKLOAD_IDENTITY_TOKEN"
+)
+
+type oauthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type gitHubActionsIdTokenResponse struct {
+	Value string `json:"value"`
+}
+
+// Settings of the oauth block of the provider, exactly one source of external tokens must be set:
+// externalAccessToken, (externalTokenUrl, externalClientId, externalClientSecret), externalTokenFile, gitHubActionsAudience
+// or tfcWorkloadIdentity
+type oauthSettings struct {
+	identityPoolId       string
+	externalTokenUrl     string
+	externalClientId     string
+	externalClientSecret string
+	externalTokenScope   string
+	externalAccessToken  string
+	// For example, a projected service account token of GKE or EKS that is rotated by the kubelet
+	externalTokenFile     string
+	gitHubActionsAudience string
+	tfcWorkloadIdentity   bool
+	// Selects one of the tokens of a workspace with multiple audiences
+	tfcWorkloadIdentityTag string
+}
+
+// Issues OAuth tokens for an identity pool: external tokens (issued by the identity provider) are used
+// for Kafka REST calls, and Confluent Cloud STS tokens (exchanged from external tokens) are used for Cloud API calls.
+// Tokens are cached and refreshed shortly before they expire.
+type oauthToken struct {
+	mu                          sync.Mutex
+	httpClient                  *http.Client
+	stsTokenUrl                 string
+	settings                    oauthSettings
+	gitHubActionsIdTokenUrl     string
+	gitHubActionsIdRequestToken string
+	externalToken               string
+	externalTokenExpiresAt      time.Time
+	stsToken                    string
+	stsTokenExpiresAt           time.Time
+}
+
+func newOAuthToken(httpClient *http.Client, endpoint string, settings oauthSettings) (*oauthToken, error) {
+	if settings.identityPoolId == "" {
+		return nil, fmt.Errorf("%s must be set", paramOAuthIdentityPoolId)
+	}
+	isClientCredentialsGrantSet := settings.externalTokenUrl != "" && settings.externalClientId != "" && settings.externalClientSecret != ""
+	isClientCredentialsGrantPartiallySet := settings.externalTokenUrl != "" || settings.externalClientId != "" || settings.externalClientSecret != ""
+	if isClientCredentialsGrantPartiallySet && !isClientCredentialsGrantSet {
+		return nil, fmt.Errorf("all 3 %s, %s, %s attributes must be set at the same time", paramOAuthExternalTokenUrl, paramOAuthExternalClientId, paramOAuthExternalClientSecret)
+	}
+	externalTokenSourcesCount := 0
+	for _, isSet := range []bool{settings.externalAccessToken != "", isClientCredentialsGrantPartiallySet, settings.externalTokenFile != "", settings.gitHubActionsAudience != "", settings.tfcWorkloadIdentity} {
+		if isSet {
+			externalTokenSourcesCount++
+		}
+	}
+	if externalTokenSourcesCount != 1 {
+		return nil, fmt.Errorf("exactly one of %s, (%s, %s, %s), %s, %s or %s must be set", paramOAuthExternalAccessToken,
+			paramOAuthExternalTokenUrl, paramOAuthExternalClientId, paramOAuthExternalClientSecret, paramOAuthExternalTokenFile, paramOAuthGitHubActionsAudience, paramOAuthTfcWorkloadIdentity)
+	}
+	if settings.tfcWorkloadIdentityTag != "" && !settings.tfcWorkloadIdentity {
+		return nil, fmt.Errorf("%s can only be set when %s is true", paramOAuthTfcWorkloadIdentityTag, paramOAuthTfcWorkloadIdentity)
+	}
+	if settings.tfcWorkloadIdentity {
+		// The token is valid for the whole plan or apply, so it's used the same way as a static external token
+		envVar := tfcWorkloadIdentityTokenEnvVarFor(settings.tfcWorkloadIdentityTag)
+		settings.externalAccessToken = os.Getenv(envVar)
+		if settings.externalAccessToken == "" {
+			return nil, fmt.Errorf("%s is set but %s environment variable is not, make sure the run is in HCP Terraform and the workspace has %s variable set",
+				paramOAuthTfcWorkloadIdentity, envVar, strings.Replace(envVar, "_TOKEN", "_AUDIENCE", 1))
+		}
+	}
+	token := &oauthToken{
+		httpClient:  httpClient,
+		stsTokenUrl: strings.TrimSuffix(endpoint, "/") + stsTokenPath,
+		settings:    settings,
+		// A static external token never expires from the provider's point of view
+		externalToken: settings.externalAccessToken,
+	}
+	if settings.gitHubActionsAudience != "" {
+		token.gitHubActionsIdTokenUrl = os.Getenv(gitHubActionsIdTokenRequestUrlEnvVar)
+		token.gitHubActionsIdRequestToken = os.Getenv(gitHubActionsIdTokenRequestTokenEnvVar)
+		if token.gitHubActionsIdTokenUrl == "" || token.gitHubActionsIdRequestToken == "" {
+			return nil, fmt.Errorf("%s is set but %s and %s environment variables are not, make sure the GitHub Actions job has \"id-token: write\" permission",
+				paramOAuthGitHubActionsAudience, gitHubActionsIdTokenRequestUrlEnvVar, gitHubActionsIdTokenRequestTokenEnvVar)
+		}
+	}
+	return token, nil
+}
+
+// Returns the environment variable HCP Terraform injects the workload identity token for the tag into
+func tfcWorkloadIdentityTokenEnvVarFor(tag string) string {
+	if tag == "" {
+		return tfcWorkloadIdentityTokenEnvVar
+	}
+	return fmt.Sprintf("%s_%s", tfcWorkloadIdentityTokenEnvVar, strings.ToUpper(tag))
+}
+
+func (t *oauthToken) externalAccessToken(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.refreshExternalToken(ctx)
+}
+
+func (t *oauthToken) stsAccessToken(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.stsToken != "" && time.Now().Before(t.stsTokenExpiresAt) {
+		return t.stsToken, nil
+	}
+	externalToken, err := t.refreshExternalToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Exchanging external token for a Confluent Cloud token for Identity Pool %q", t.settings.identityPoolId))
+	response, err := t.requestToken(ctx, url.Values{
+		"grant_type":           {grantTypeTokenExchange},
+		"subject_token":        {externalToken},
+		"subject_token_type":   {tokenTypeJwt},
+		"requested_token_type": {tokenTypeAccessToken},
+		"identity_pool_id":     {t.settings.identityPoolId},
+	}, t.stsTokenUrl)
+	if err != nil {
+		return "", fmt.Errorf("error exchanging external token for Identity Pool %q: %s", t.settings.identityPoolId, err)
+	}
+	t.stsToken = response.AccessToken
+	t.stsTokenExpiresAt = tokenExpiresAt(response.ExpiresIn)
+	return t.stsToken, nil
+}
+
+// Must be called with t.mu locked
+func (t *oauthToken) refreshExternalToken(ctx context.Context) (string, error) {
+	if t.settings.externalAccessToken != "" || (t.externalToken != "" && time.Now().Before(t.externalTokenExpiresAt)) {
+		return t.externalToken, nil
+	}
+	var externalToken string
+	var expiresAt time.Time
+	switch {
+	case t.settings.externalTokenFile != "":
+		tflog.Debug(ctx, fmt.Sprintf("Reading external token from %q", t.settings.externalTokenFile))
+		content, err := ioutil.ReadFile(t.settings.externalTokenFile)
+		if err != nil {
+			return "", fmt.Errorf("error reading external token from %q: %s", t.settings.externalTokenFile, err)
+		}
+		externalToken = strings.TrimSpace(string(content))
+		if externalToken == "" {
+			return "", fmt.Errorf("error reading external token from %q: the file is empty", t.settings.externalTokenFile)
+		}
+		expiresAt = jwtExpiresAt(externalToken)
+	case t.settings.gitHubActionsAudience != "":
+		tflog.Debug(ctx, fmt.Sprintf("Requesting GitHub Actions OIDC token for audience %q", t.settings.gitHubActionsAudience))
+		token, err := t.requestGitHubActionsIdToken(ctx)
+		if err != nil {
+			return "", fmt.Errorf("error requesting GitHub Actions OIDC token: %s", err)
+		}
+		externalToken = token
+		expiresAt = jwtExpiresAt(externalToken)
+	default:
+		tflog.Debug(ctx, fmt.Sprintf("Requesting external token from %q", t.settings.externalTokenUrl))
+		values := url.Values{
+			"grant_type":    {grantTypeClientCredentials},
+			"client_id":     {t.settings.externalClientId},
+			"client_secret": {t.settings.externalClientSecret},
+		}
+		if t.settings.externalTokenScope != "" {
+			values.Set("scope", t.settings.externalTokenScope)
+		}
+		response, err := t.requestToken(ctx, values, t.settings.externalTokenUrl)
+		if err != nil {
+			return "", fmt.Errorf("error requesting external token from %q: %s", t.settings.externalTokenUrl, err)
+		}
+		externalToken = response.AccessToken
+		expiresAt = tokenExpiresAt(response.ExpiresIn)
+	}
+	if externalToken != t.externalToken {
+		// The STS token was exchanged from the previous external token
+		t.stsToken = ""
+	}
+	t.externalToken = externalToken
+	t.externalTokenExpiresAt = expiresAt
+	return t.externalToken, nil
+}
+
+// See https://docs.github.com/en/actions/deployment/security-hardening-your-deployments/about-security-hardening-with-openid-connect
+func (t *oauthToken) requestGitHubActionsIdToken(ctx context.Context) (string, error) {
+	requestUrl, err := url.Parse(t.gitHubActionsIdTokenUrl)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s environment variable: %s", gitHubActionsIdTokenRequestUrlEnvVar, err)
+	}
+	query := requestUrl.Query()
+	query.Set("audience", t.settings.gitHubActionsAudience)
+	requestUrl.RawQuery = query.Encode()
+	// The ID token is returned in the "value" field that debug_http wouldn't redact
+	req, err := http.NewRequestWithContext(withoutDebugHttpBodies(ctx), http.MethodGet, requestUrl.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+t.gitHubActionsIdRequestToken)
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("received a response with unexpected %d status code: %s", resp.StatusCode, body)
+	}
+	response := &gitHubActionsIdTokenResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return "", err
+	}
+	if response.Value == "" {
+		return "", fmt.Errorf("received a response without a token")
+	}
+	return response.Value, nil
+}
+
+func (t *oauthToken) requestToken(ctx context.Context, values url.Values, tokenUrl string) (*oauthTokenResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenUrl, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received a response with unexpected %d status code: %s", resp.StatusCode, body)
+	}
+	response := &oauthTokenResponse{}
+	if err := json.Unmarshal(body, response); err != nil {
+		return nil, err
+	}
+	if response.AccessToken == "" {
+		return nil, fmt.Errorf("received a response without an access token")
+	}
+	return response, nil
+}
+
+func tokenExpiresAt(expiresIn int64) time.Time {
+	// Tokens without "expires_in" would be expired right away
+	if expiresIn <= 0 {
+		return time.Now().Add(defaultExternalTokenLifetime)
+	}
+	lifetime := time.Duration(expiresIn) * time.Second
+	// Tokens that live for less than twice the safety interval are refreshed halfway through their lifetime,
+	// otherwise they would be requested again for every request
+	refreshAfter := lifetime - tokenExpirationSafetyInterval
+	if refreshAfter < lifetime/2 {
+		refreshAfter = lifetime / 2
+	}
+	return time.Now().Add(refreshAfter)
+}
+
+// Returns when a JWT should be refreshed based on its "exp" claim, the signature is not verified
+// since tokens are only passed on to Confluent Cloud STS that verifies them
+func jwtExpiresAt(token string) time.Time {
+	defaultExpiresAt := time.Now().Add(defaultExternalTokenLifetime)
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return defaultExpiresAt
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return defaultExpiresAt
+	}
+	claims := struct {
+		ExpiresAt int64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.ExpiresAt == 0 {
+		return defaultExpiresAt
+	}
+	return time.Unix(claims.ExpiresAt, 0).Add(-tokenExpirationSafetyInterval)
+}
@@ -0,0 +1,254 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	cmk "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewOAuthTokenValidation(t *testing.T) {
+	httpClient := createRetryableHttpClientWithExponentialBackoff()
+	clientCredentials := oauthSettings{identityPoolId: "pool-abc123", externalTokenUrl: "https://idp.example.com/token", externalClientId: "client", externalClientSecret: "secret"}
+	if _, err := newOAuthToken(httpClient, "https://api.confluent.cloud", oauthSettings{externalAccessToken: "token"}); err == nil {
+		t.Fatalf("Expected an error when the identity pool ID is missing")
+	}
+	if _, err := newOAuthToken(httpClient, "https://api.confluent.cloud", oauthSettings{identityPoolId: "pool-abc123"}); err == nil {
+		t.Fatalf("Expected an error when neither an external token nor client credentials are set")
+	}
+	if _, err := newOAuthToken(httpClient, "https://api.confluent.cloud", oauthSettings{identityPoolId: "pool-abc123", externalTokenUrl: "https://idp.example.com/token", externalAccessToken: "token"}); err == nil {
+		t.Fatalf("Expected an error when both an external token and client credentials are set")
+	}
+	if _, err := newOAuthToken(httpClient, "https://api.confluent.cloud", oauthSettings{identityPoolId: "pool-abc123", externalTokenUrl: "https://idp.example.com/token"}); err == nil {
+		t.Fatalf("Expected an error when client credentials are partially set")
+	}
+	if _, err := newOAuthToken(httpClient, "https://api.confluent.cloud", oauthSettings{identityPoolId: "pool-abc123", externalTokenFile: "/var/run/secrets/token", externalAccessToken: "token"}); err == nil {
+		t.Fatalf("Expected an error when both an external token and an external token file are set")
+	}
+	if _, err := newOAuthToken(httpClient, "https://api.confluent.cloud", clientCredentials); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	t.Setenv(gitHubActionsIdTokenRequestUrlEnvVar, "")
+	if _, err := newOAuthToken(httpClient, "https://api.confluent.cloud", oauthSettings{identityPoolId: "pool-abc123", gitHubActionsAudience: "confluent"}); err == nil {
+		t.Fatalf("Expected an error when GitHub Actions OIDC token request environment variables are not set")
+	}
+}
+
+func TestOAuthTokenExchange(t *testing.T) {
+	requestCounts := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		requestCounts[r.URL.Path]++
+		switch r.URL.Path {
+		case "/idp/token":
+			if r.PostForm.Get("grant_type") != grantTypeClientCredentials || r.PostForm.Get("client_id") != "client" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token": "external-token", "expires_in": 3600}`))
+		case stsTokenPath:
+			if r.PostForm.Get("subject_token") != "external-token" || r.PostForm.Get("identity_pool_id") != "pool-abc123" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token": "sts-token", "expires_in": 900}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	token, err := newOAuthToken(createRetryableHttpClientWithExponentialBackoff(), server.URL, oauthSettings{
+		identityPoolId:       "pool-abc123",
+		externalTokenUrl:     server.URL + "/idp/token",
+		externalClientId:     "client",
+		externalClientSecret: "secret",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	for i := 0; i < 2; i++ {
+		stsToken, err := token.stsAccessToken(context.Background())
+		if err != nil || stsToken != "sts-token" {
+			t.Fatalf("Unexpected STS token %q: %v", stsToken, err)
+		}
+		externalToken, err := token.externalAccessToken(context.Background())
+		if err != nil || externalToken != "external-token" {
+			t.Fatalf("Unexpected external token %q: %v", externalToken, err)
+		}
+	}
+	// Tokens are cached until they expire
+	if requestCounts["/idp/token"] != 1 || requestCounts[stsTokenPath] != 1 {
+		t.Fatalf("Unexpected token request counts: %v", requestCounts)
+	}
+}
+
+func TestApiContextFailsWithoutOAuthToken(t *testing.T) {
+	apiRequestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/idp/token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		apiRequestCount++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "lkc-abc123"}`))
+	}))
+	defer server.Close()
+
+	token, err := newOAuthToken(createRetryableHttpClientWithExponentialBackoff(), server.URL, oauthSettings{
+		identityPoolId:       "pool-abc123",
+		externalTokenUrl:     server.URL + "/idp/token",
+		externalClientId:     "client",
+		externalClientSecret: "secret",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	cmkCfg := cmk.NewConfiguration()
+	cmkCfg.Servers[0].URL = server.URL
+	cmkCfg.HTTPClient = createRetryableHttpClient(defaultHttpClientSettings())
+	c := &Client{cmkClient: cmk.NewAPIClient(cmkCfg), oauthToken: token}
+	kafkaRestCfg := kafkarestv3.NewConfiguration()
+	kafkaRestCfg.BasePath = server.URL + "/kafka/v3"
+	kafkaRestCfg.HTTPClient = createRetryableHttpClient(defaultHttpClientSettings())
+	kafkaRestClient := &KafkaRestClient{apiClient: kafkarestv3.NewAPIClient(kafkaRestCfg), clusterId: "lkc-abc123", oauthToken: token}
+
+	_, _, err = c.cmkClient.ClustersCmkV2Api.GetCmkV2Cluster(c.cmkApiContext(context.Background()), "lkc-abc123").Environment("env-abc123").Execute()
+	if err == nil || !strings.Contains(err.Error(), "could not get OAuth token") {
+		t.Fatalf("Expected an OAuth token error, got %v", err)
+	}
+	_, _, err = kafkaRestClient.apiClient.TopicV3Api.GetKafkaV3Topic(kafkaRestClient.apiContext(context.Background()), "lkc-abc123", "orders")
+	if err == nil || !strings.Contains(err.Error(), `could not get OAuth token for Kafka Cluster "lkc-abc123"`) {
+		t.Fatalf("Expected an OAuth token error, got %v", err)
+	}
+	// Requests are never sent unauthenticated
+	if apiRequestCount != 0 {
+		t.Fatalf("Expected no API requests, got %d", apiRequestCount)
+	}
+}
+
+func TestTokenExpiresAt(t *testing.T) {
+	now := time.Now()
+	if expiresAt := tokenExpiresAt(0); !expiresAt.After(now) {
+		t.Fatalf("Expected a token without expires_in to expire in the future, got %s", expiresAt)
+	}
+	if expiresAt := tokenExpiresAt(3600); expiresAt.Before(now.Add(time.Hour-tokenExpirationSafetyInterval)) || expiresAt.After(time.Now().Add(time.Hour)) {
+		t.Fatalf("Unexpected expiration time %s", expiresAt)
+	}
+	// Short-lived tokens are refreshed halfway through their lifetime instead of right away
+	for _, expiresIn := range []int64{1, 30, 60, 90} {
+		lifetime := time.Duration(expiresIn) * time.Second
+		if expiresAt := tokenExpiresAt(expiresIn); expiresAt.Before(now.Add(lifetime/2)) || expiresAt.After(time.Now().Add(lifetime)) {
+			t.Fatalf("Unexpected expiration time %s for a token that expires in %s", expiresAt, lifetime)
+		}
+	}
+}
+
+func TestOAuthTokenRequestUsesContext(t *testing.T) {
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{"access_token": "token", "expires_in": 3600}`)
+	}))
+	defer server.Close()
+
+	token := &oauthToken{httpClient: server.Client()}
+	values := url.Values{"grant_type": {grantTypeClientCredentials}}
+	if response, err := token.requestToken(context.Background(), values, server.URL); err != nil || response.AccessToken != "token" {
+		t.Fatalf("Expected an access token, got %v, %v", response, err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := token.requestToken(ctx, values, server.URL); err == nil || !strings.Contains(err.Error(), context.Canceled.Error()) {
+		t.Fatalf("Expected the request to be canceled with the context, got %v", err)
+	}
+	if requestCount != 1 {
+		t.Fatalf("Expected 1 token request, got %d", requestCount)
+	}
+}
+
+func TestOAuthTokenFromWorkloadIdentity(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).Unix()
+	jwt := "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"repo:org/repo","exp":%d}`, expiresAt))) + ".signature"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/github/token":
+			if r.Header.Get("Authorization") != "Bearer request-token" || r.URL.Query().Get("audience") != "confluent" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"value": %q}`, jwt)))
+		case stsTokenPath:
+			_ = r.ParseForm()
+			if r.PostForm.Get("subject_token") != jwt {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token": "sts-token", "expires_in": 900}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv(gitHubActionsIdTokenRequestUrlEnvVar, server.URL+"/github/token?api-version=2.0")
+	t.Setenv(gitHubActionsIdTokenRequestTokenEnvVar, "request-token")
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(tokenFile, []byte(jwt+"\n"), 0600); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	for _, settings := range []oauthSettings{
+		{identityPoolId: "pool-abc123", gitHubActionsAudience: "confluent"},
+		{identityPoolId: "pool-abc123", externalTokenFile: tokenFile},
+	} {
+		token, err := newOAuthToken(createRetryableHttpClientWithExponentialBackoff(), server.URL, settings)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if stsToken, err := token.stsAccessToken(context.Background()); err != nil || stsToken != "sts-token" {
+			t.Fatalf("Unexpected STS token %q: %v", stsToken, err)
+		}
+		if !token.externalTokenExpiresAt.Equal(time.Unix(expiresAt, 0).Add(-tokenExpirationSafetyInterval)) {
+			t.Fatalf("Expected the external token to be refreshed based on its exp claim, got %s", token.externalTokenExpiresAt)
+		}
+	}
+
+	tfcSettings := oauthSettings{identityPoolId: "pool-abc123", tfcWorkloadIdentity: true, tfcWorkloadIdentityTag: "confluent"}
+	if _, err := newOAuthToken(createRetryableHttpClientWithExponentialBackoff(), server.URL, tfcSettings); err == nil {
+		t.Fatalf("Expected an error when the HCP Terraform workload identity token is not set")
+	}
+	t.Setenv("TFC_WORKLOAD_IDENTITY_TOKEN_CONFLUENT", jwt)
+	token, err := newOAuthToken(createRetryableHttpClientWithExponentialBackoff(), server.URL, tfcSettings)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if stsToken, err := token.stsAccessToken(context.Background()); err != nil || stsToken != "sts-token" {
+		t.Fatalf("Unexpected STS token %q: %v", stsToken, err)
+	}
+}
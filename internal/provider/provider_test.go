@@ -15,10 +15,14 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // TODO: add a test suite that wraps up all these variables in a class
@@ -41,8 +45,8 @@ func init() {
 		},
 	}
 	// Set fake values for secrets since those are required
-	_ = os.Setenv("CONFLUENT_CLOUD_API_KEY", "foo")
-	_ = os.Setenv("CONFLUENT_CLOUD_API_SECRET", "bar")
+	_ = os.Setenv("CONFLUENT_CLOUD_API_KEY", "FOOFOOFOOFOOFOO1")
+	_ = os.Setenv("CONFLUENT_CLOUD_API_SECRET", "barbarbarbarbarbarbarbarbarbarbarbarbarbarbarbarbarbarbarbarbar1")
 }
 
 func TestProvider_InternalValidate(t *testing.T) {
@@ -51,6 +55,67 @@ func TestProvider_InternalValidate(t *testing.T) {
 	}
 }
 
+func TestProvider_ValidateEmptyConfig(t *testing.T) {
+	diags := New(testVersion)().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{}))
+	if diags.HasError() {
+		t.Fatalf("Expected an empty provider block to be valid, got %v", diags)
+	}
+}
+
+func TestProvider_ValidateSchemaRegistryRestEndpoint(t *testing.T) {
+	p := New(testVersion)()
+	for endpoint, isValid := range map[string]bool{
+		"": true,
+		"https://psrc-00000.us-central1.gcp.confluent.cloud": true,
+		"http://psrc-00000.us-central1.gcp.confluent.cloud":  false,
+		"psrc-00000.us-central1.gcp.confluent.cloud":         false,
+	} {
+		diags := p.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{"schema_registry_rest_endpoint": endpoint}))
+		if diags.HasError() == isValid {
+			t.Fatalf("Unexpected validation result for schema_registry_rest_endpoint %q: %v", endpoint, diags)
+		}
+	}
+}
+
+func TestProvider_ConfigureKafkaRestProxy(t *testing.T) {
+	var kafkaRestPaths []string
+	var kafkaRestUsername string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/org/v2/environments" {
+			kafkaRestPaths = append(kafkaRestPaths, r.URL.Path)
+			kafkaRestUsername, _, _ = r.BasicAuth()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	// REST Proxy usernames and passwords don't follow the format of Confluent Cloud API Keys
+	raw := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"endpoint":             server.URL,
+		"kafka_rest_base_path": "/v3",
+		"kafka_id":             "ZWe3nnZwTrKSM0aM2doAxQ",
+		"kafka_rest_endpoint":  server.URL,
+		"kafka_api_key":        "admin",
+		"kafka_api_secret":     "admin-secret",
+	})
+	p := New(testVersion)()
+	if diags := p.Validate(raw); diags.HasError() {
+		t.Fatalf("Expected the Kafka REST Proxy configuration to be valid, got %v", diags)
+	}
+	if diags := p.Configure(context.Background(), raw); diags.HasError() {
+		t.Fatalf("Expected the Kafka REST Proxy configuration to be accepted, got %v", diags)
+	}
+	client := p.Meta().(*Client)
+	if !client.isKafkaMetadataSet || !client.isSelfManagedKafka() {
+		t.Fatalf("Expected the Kafka REST Proxy to be configured as a self-managed Kafka cluster")
+	}
+	// The credentials are validated by reading the cluster under the base path of the proxy
+	if len(kafkaRestPaths) != 1 || kafkaRestPaths[0] != "/v3/clusters/ZWe3nnZwTrKSM0aM2doAxQ" || kafkaRestUsername != "admin" {
+		t.Fatalf("Unexpected Kafka REST requests %v authenticated as %q", kafkaRestPaths, kafkaRestUsername)
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	ccApiKey := getEnv("CONFLUENT_CLOUD_API_KEY", "")
 	ccApiSecret := getEnv("CONFLUENT_CLOUD_API_SECRET", "")
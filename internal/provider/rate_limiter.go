@@ -0,0 +1,103 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// A token bucket that is refilled with requestsPerSecond tokens per second and holds up to burst tokens
+type rateLimiter struct {
+	mu                sync.Mutex
+	requestsPerSecond float64
+	burst             float64
+	tokens            float64
+	lastRefill        time.Time
+	now               func() time.Time
+}
+
+// Returns a rate limiter that allows requestsPerSecond requests on average and bursts of up to burst requests,
+// or nil if requestsPerSecond is 0. The burst defaults to requestsPerSecond rounded up.
+func createRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = int(math.Ceil(requestsPerSecond))
+	}
+	return &rateLimiter{
+		requestsPerSecond: requestsPerSecond,
+		burst:             float64(burst),
+		tokens:            float64(burst),
+		lastRefill:        time.Now(),
+		now:               time.Now,
+	}
+}
+
+// Takes a token and returns how long to wait for before sending a request,
+// tokens can go negative so that waiting requests are served in order
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.lastRefill).Seconds()*l.requestsPerSecond)
+	l.lastRefill = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.requestsPerSecond * float64(time.Second))
+}
+
+func (l *rateLimiter) wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay == 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Waits for the rate limiter before every HTTP request attempt, including retries,
+// the rate limiter is shared by all HTTP clients of the provider
+type rateLimitingRoundTripper struct {
+	Transport http.RoundTripper
+	limiter   *rateLimiter
+}
+
+func (t *rateLimitingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var transport http.RoundTripper
+	if t.Transport != nil {
+		transport = t.Transport
+	} else {
+		transport = http.DefaultTransport
+	}
+
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	return transport.RoundTrip(req)
+}
@@ -0,0 +1,47 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	if limiter := createRateLimiter(0, 10); limiter != nil {
+		t.Fatalf("Expected no rate limiter when requests_per_second is 0")
+	}
+	now := time.Unix(0, 0)
+	limiter := createRateLimiter(2, 0)
+	limiter.now = func() time.Time { return now }
+	limiter.lastRefill = now
+
+	// The burst defaults to requests_per_second
+	for i := 0; i < 2; i++ {
+		if delay := limiter.reserve(); delay != 0 {
+			t.Fatalf("Expected request #%d not to wait, got %s", i+1, delay)
+		}
+	}
+	if delay := limiter.reserve(); delay != 500*time.Millisecond {
+		t.Fatalf("Expected the 3rd request to wait for 500ms, got %s", delay)
+	}
+	if delay := limiter.reserve(); delay != time.Second {
+		t.Fatalf("Expected the 4th request to wait for 1s, got %s", delay)
+	}
+	now = now.Add(10 * time.Second)
+	if delay := limiter.reserve(); delay != 0 {
+		t.Fatalf("Expected a request not to wait after the bucket is refilled, got %s", delay)
+	}
+}
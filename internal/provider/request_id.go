@@ -0,0 +1,84 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+const (
+	requestIdHeader = "X-Request-Id"
+	// The key the request ID is stored under in the error response body
+	requestIdBodyKey = "request_id"
+)
+
+// Copies the X-Request-Id header of unsuccessful responses into their JSON body, since errors returned by
+// the SDKs only expose the body while the request ID is what Confluent Support asks for
+type requestIdRoundTripper struct {
+	Transport http.RoundTripper
+}
+
+func (t *requestIdRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var transport http.RoundTripper
+	if t.Transport != nil {
+		transport = t.Transport
+	} else {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil || resp == nil || resp.StatusCode < http.StatusBadRequest || resp.Body == nil {
+		return resp, err
+	}
+	requestId := resp.Header.Get(requestIdHeader)
+	if requestId == "" {
+		return resp, err
+	}
+	body, readErr := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if readErr != nil {
+		resp.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(body), &errorReader{readErr}))
+		return resp, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(addRequestIdToBody(body, requestId)))
+	resp.ContentLength = -1
+	resp.Header.Del("Content-Length")
+	return resp, err
+}
+
+// Returns the body with the request ID added if it's a JSON object that doesn't have one yet
+func addRequestIdToBody(body []byte, requestId string) []byte {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil || fields == nil {
+		return body
+	}
+	if _, ok := fields[requestIdBodyKey]; ok {
+		return body
+	}
+	encodedRequestId, err := json.Marshal(requestId)
+	if err != nil {
+		return body
+	}
+	fields[requestIdBodyKey] = encodedRequestId
+	updatedBody, err := json.Marshal(fields)
+	if err != nil {
+		return body
+	}
+	return updatedBody
+}
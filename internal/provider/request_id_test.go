@@ -0,0 +1,55 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestIdRoundTripper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIdHeader, "req-123")
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/ok" {
+			_, _ = w.Write([]byte(`{"id":"env-abc123"}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors":[{"detail":"invalid"}]}`))
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: &requestIdRoundTripper{}}
+
+	for path, expectedBody := range map[string]string{
+		"/ok":    `{"id":"env-abc123"}`,
+		"/error": `{"errors":[{"detail":"invalid"}],"request_id":"req-123"}`,
+	} {
+		resp, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+		if string(body) != expectedBody {
+			t.Fatalf("expected %s body to be %s, got %s", path, expectedBody, body)
+		}
+	}
+}
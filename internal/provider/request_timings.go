@@ -0,0 +1,197 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"math"
+	"math/rand"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	requestTimingsLoggingKey = "request_timings"
+
+	// The maximum number of latencies that are kept per endpoint, so that long applies use bounded memory
+	requestTimingsReservoirSize = 1000
+)
+
+var apiVersionPathSegmentRegex = regexp.MustCompile(`^v\d+`)
+
+// Latencies of HTTP requests sent by the provider, shared by all HTTP clients of the provider process
+var providerRequestTimings = newRequestTimings()
+
+// Percentiles are computed from a uniform random sample (reservoir) of the latencies of an endpoint,
+// which is exact as long as the endpoint has received at most requestTimingsReservoirSize requests
+type endpointTimings struct {
+	durations []time.Duration
+	count     int
+	throttled int
+}
+
+type requestTimings struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointTimings
+	random    *rand.Rand
+}
+
+func newRequestTimings() *requestTimings {
+	return &requestTimings{endpoints: make(map[string]*endpointTimings), random: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+func (t *requestTimings) record(endpoint string, duration time.Duration, statusCode int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	timings, ok := t.endpoints[endpoint]
+	if !ok {
+		timings = &endpointTimings{}
+		t.endpoints[endpoint] = timings
+	}
+	timings.count++
+	// Reservoir sampling (Algorithm R): the n-th latency replaces a random sample with probability size/n
+	if len(timings.durations) < requestTimingsReservoirSize {
+		timings.durations = append(timings.durations, duration)
+	} else if i := t.random.Intn(timings.count); i < requestTimingsReservoirSize {
+		timings.durations[i] = duration
+	}
+	if statusCode == http.StatusTooManyRequests {
+		timings.throttled++
+	}
+}
+
+// Returns one line per endpoint, for example, "GET /cmk/v2/clusters/{id}: count=3, throttled=0, p50=120ms, p95=340ms"
+func (t *requestTimings) summary() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	lines := make([]string, 0, len(t.endpoints))
+	for endpoint, timings := range t.endpoints {
+		durations := make([]time.Duration, len(timings.durations))
+		copy(durations, timings.durations)
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+		lines = append(lines, fmt.Sprintf("%s: count=%d, throttled=%d, p50=%s, p95=%s", endpoint, timings.count, timings.throttled,
+			percentile(durations, 0.50).Round(time.Millisecond), percentile(durations, 0.95).Round(time.Millisecond)))
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+// Uses the nearest-rank method, durations must be sorted
+func percentile(durations []time.Duration, p float64) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(durations)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return durations[rank]
+}
+
+// Replaces resource IDs in the path of a request with "{id}" so that requests to the same endpoint are aggregated,
+// for example, "/kafka/v3/clusters/lkc-abc123/topics/orders" becomes "/kafka/v3/clusters/{id}/topics/{id}".
+// Path segments after the API version segment alternate between collection names and resource IDs.
+func requestTimingsEndpoint(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	versionIndex := -1
+	for i, segment := range segments {
+		if apiVersionPathSegmentRegex.MatchString(segment) {
+			versionIndex = i
+			break
+		}
+	}
+	if versionIndex >= 0 {
+		for i := versionIndex + 2; i < len(segments); i += 2 {
+			segments[i] = "{id}"
+		}
+	}
+	return fmt.Sprintf("%s /%s", req.Method, strings.Join(segments, "/"))
+}
+
+// Records the latency of every HTTP request attempt, including the ones retried because of throttling
+type requestTimingRoundTripper struct {
+	Transport http.RoundTripper
+	timings   *requestTimings
+}
+
+func (t *requestTimingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var transport http.RoundTripper
+	if t.Transport != nil {
+		transport = t.Transport
+	} else {
+		transport = http.DefaultTransport
+	}
+
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	t.timings.record(requestTimingsEndpoint(req), time.Since(start), statusCode)
+
+	return resp, err
+}
+
+// Wraps CRUD functions of every resource and data source so that they log the aggregated latencies
+// of all HTTP requests sent so far, so the last summary in the debug log covers the whole plan or apply
+func addRequestTimingsLogging(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		if r.CreateContext != nil {
+			r.CreateContext = logRequestTimingsAfter(r.CreateContext)
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = logRequestTimingsAfter(r.ReadContext)
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = logRequestTimingsAfter(r.UpdateContext)
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = logRequestTimingsAfter(r.DeleteContext)
+		}
+	}
+}
+
+func logRequestTimingsAfter(operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := operation(ctx, d, meta)
+		// Building the summary sorts the latencies of every endpoint, so it's skipped unless it's logged
+		if isDebugLoggingEnabled() {
+			tflog.Debug(ctx, "Aggregated HTTP request timings", map[string]interface{}{requestTimingsLoggingKey: providerRequestTimings.summary()})
+		}
+		return diags
+	}
+}
+
+// Returns whether debug logs of the provider are written, that is, whether TF_LOG_PROVIDER (or TF_LOG if it's not set)
+// is DEBUG, TRACE or JSON, see https://www.terraform.io/internals/debugging
+func isDebugLoggingEnabled() bool {
+	level := os.Getenv("TF_LOG_PROVIDER")
+	if level == "" {
+		level = os.Getenv(logging.EnvLog)
+	}
+	level = strings.ToUpper(level)
+	return level == "DEBUG" || level == "TRACE" || level == "JSON"
+}
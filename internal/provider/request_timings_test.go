@@ -0,0 +1,84 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRequestTimingsEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"/cmk/v2/clusters/lkc-abc123":                                         "GET /cmk/v2/clusters/{id}",
+		"/kafka/v3/clusters/lkc-abc123/topics/orders/configs":                 "GET /kafka/v3/clusters/{id}/topics/{id}/configs",
+		"/kafka/v3/clusters/lkc-abc123/acls":                                  "GET /kafka/v3/clusters/{id}/acls",
+		"/iam/v2/service-accounts":                                            "GET /iam/v2/service-accounts",
+		"/api/metadata/security/v2alpha1/principals/User:sa-1/roles/Operator": "GET /api/metadata/security/v2alpha1/principals/{id}/roles/{id}",
+	}
+	for path, expectedEndpoint := range tests {
+		req, _ := http.NewRequest(http.MethodGet, "https://api.confluent.cloud"+path, nil)
+		if endpoint := requestTimingsEndpoint(req); endpoint != expectedEndpoint {
+			t.Fatalf("Unexpected endpoint for %q: expected %q, got %q", path, expectedEndpoint, endpoint)
+		}
+	}
+}
+
+func TestRequestTimingsSummary(t *testing.T) {
+	timings := newRequestTimings()
+	for i := 1; i <= 20; i++ {
+		timings.record("GET /cmk/v2/clusters/{id}", time.Duration(i)*time.Millisecond, http.StatusOK)
+	}
+	timings.record("POST /iam/v2/service-accounts", 10*time.Millisecond, http.StatusTooManyRequests)
+
+	expectedSummary := []string{
+		"GET /cmk/v2/clusters/{id}: count=20, throttled=0, p50=10ms, p95=19ms",
+		"POST /iam/v2/service-accounts: count=1, throttled=1, p50=10ms, p95=10ms",
+	}
+	if summary := timings.summary(); !reflect.DeepEqual(summary, expectedSummary) {
+		t.Fatalf("Unexpected summary: expected %v, got %v", expectedSummary, summary)
+	}
+}
+
+func TestRequestTimingsKeepBoundedLatencies(t *testing.T) {
+	timings := newRequestTimings()
+	for i := 0; i < 3*requestTimingsReservoirSize; i++ {
+		timings.record("GET /cmk/v2/clusters/{id}", 10*time.Millisecond, http.StatusOK)
+	}
+	if kept := len(timings.endpoints["GET /cmk/v2/clusters/{id}"].durations); kept != requestTimingsReservoirSize {
+		t.Fatalf("Expected %d latencies to be kept, got %d", requestTimingsReservoirSize, kept)
+	}
+	expectedSummary := []string{"GET /cmk/v2/clusters/{id}: count=3000, throttled=0, p50=10ms, p95=10ms"}
+	if summary := timings.summary(); !reflect.DeepEqual(summary, expectedSummary) {
+		t.Fatalf("Unexpected summary: expected %v, got %v", expectedSummary, summary)
+	}
+}
+
+func TestIsDebugLoggingEnabled(t *testing.T) {
+	t.Setenv("TF_LOG", "")
+	t.Setenv("TF_LOG_PROVIDER", "")
+	if isDebugLoggingEnabled() {
+		t.Fatalf("Expected debug logging to be disabled")
+	}
+	t.Setenv("TF_LOG", "debug")
+	if !isDebugLoggingEnabled() {
+		t.Fatalf("Expected debug logging to be enabled by TF_LOG")
+	}
+	t.Setenv("TF_LOG_PROVIDER", "WARN")
+	if isDebugLoggingEnabled() {
+		t.Fatalf("Expected TF_LOG_PROVIDER to take precedence over TF_LOG")
+	}
+}
@@ -27,27 +27,45 @@ import (
 	"os"
 	"regexp"
 	"strings"
+	"time"
 )
 
 const (
 	paramOwner               = "owner"
 	paramResource            = "managed_resource"
 	paramDisableWaitForReady = "disable_wait_for_ready"
+	paramKeepers             = "keepers"
 
 	serviceAccountKind   = "ServiceAccount"
 	userKind             = "User"
 	clusterKind          = "Cluster"
+	regionKind           = "Region"
 	cloudKindInLowercase = "cloud"
 
-	iamApiVersion = "iam/v2"
-	cmkApiVersion = "cmk/v2"
+	iamApiVersion      = "iam/v2"
+	cmkApiVersion      = "cmk/v2"
+	srcmApiVersion     = "srcm/v2"
+	ksqldbcmApiVersion = "ksqldbcm/v2"
+	fcpmApiVersion     = "fcpm/v2"
+
+	apiKeySyncTimeout = 20 * time.Minute
 )
 
 var acceptedOwnerKinds = []string{serviceAccountKind, userKind}
-var acceptedResourceKinds = []string{clusterKind}
+var acceptedResourceKinds = []string{clusterKind, regionKind}
 
 var acceptedOwnerApiVersions = []string{iamApiVersion}
-var acceptedResourceApiVersions = []string{cmkApiVersion}
+var acceptedResourceApiVersions = []string{cmkApiVersion, srcmApiVersion, ksqldbcmApiVersion, fcpmApiVersion}
+
+// Maps the API version of a managed resource to its kind and the prefix of its ID, for example,
+// Schema Registry clusters (srcm/v2) are of "Cluster" kind and have IDs like "lsrc-abc123"
+var apiKeyManagedResources = map[string][2]string{
+	cmkApiVersion:      {clusterKind, "lkc-"},
+	srcmApiVersion:     {clusterKind, "lsrc-"},
+	ksqldbcmApiVersion: {clusterKind, "lksqlc-"},
+	// Flink regions have IDs like "aws.us-east-1"
+	fcpmApiVersion: {regionKind, ""},
+}
 
 func apiKeyResource() *schema.Resource {
 	return &schema.Resource{
@@ -86,6 +104,22 @@ func apiKeyResource() *schema.Resource {
 				Default:  false,
 				ForceNew: true,
 			},
+			// Similar to the keepers of the random provider, changing any of the values rotates the API Key
+			paramKeepers: {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of the API Key, for example, to rotate it on a schedule.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(apiKeySyncTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Update: schema.DefaultTimeout(defaultResourceTimeout),
+			Delete: schema.DefaultTimeout(defaultResourceTimeout),
 		},
 	}
 }
@@ -115,7 +149,16 @@ func apiKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	if isResourceSpecificApiKey {
 		resourceId := extractStringValueFromBlock(d, paramResource, paramId)
 		resourceKind := extractStringValueFromBlock(d, paramResource, paramKind)
-		spec.SetResource(apikeys.ObjectReference{Id: resourceId, Kind: &resourceKind})
+		resourceApiVersion := extractStringValueFromBlock(d, paramResource, paramApiVersion)
+		if err := validateApiKeyManagedResource(resourceId, resourceKind, resourceApiVersion); err != nil {
+			return diag.Errorf("error creating API Key: %s", createDescriptiveError(err))
+		}
+		resource := apikeys.ObjectReference{Id: resourceId, Kind: &resourceKind, ApiVersion: &resourceApiVersion}
+		// Flink regions are scoped to an environment, so Flink API Keys must reference it
+		if resourceApiVersion == fcpmApiVersion && environmentId != "" {
+			resource.Environment = &environmentId
+		}
+		spec.SetResource(resource)
 	}
 
 	createApiKeyRequest := apikeys.IamV2ApiKey{Spec: spec}
@@ -136,7 +179,7 @@ func apiKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	if !skipSync {
 		// Wait until the API Key is synced and is ready to use
 		tflog.Debug(ctx, fmt.Sprintf("Waiting for API Key %q to sync", createdApiKey.GetId()), map[string]interface{}{apiKeyLoggingKey: createdApiKey.GetId()})
-		if err := waitForApiKeyToSync(ctx, c, createdApiKey, isResourceSpecificApiKey, environmentId); err != nil {
+		if err := waitForApiKeyToSync(ctx, c, createdApiKey, isResourceSpecificApiKey, environmentId, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return diag.FromErr(createDescriptiveError(err))
 		}
 	}
@@ -361,7 +404,7 @@ func apiKeyResourceSchema() *schema.Schema {
 		// If the resource is not specified, then Cloud API Key gets created
 		Optional:    true,
 		ForceNew:    true,
-		Description: "The resource associated with this object. The only resource that is supported is 'cmk.v2.KafkaCluster'.",
+		Description: "The resource associated with this object. The resources that are supported are 'cmk.v2.Cluster', 'srcm.v2.Cluster', 'ksqldbcm.v2.Cluster', and 'fcpm.v2.Region'.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				paramId: {
@@ -369,7 +412,7 @@ func apiKeyResourceSchema() *schema.Schema {
 					Required:     true,
 					ForceNew:     true,
 					Description:  "The unique identifier for the referred resource.",
-					ValidateFunc: validation.StringMatch(regexp.MustCompile("^lkc-"), "the resource ID must be of the form 'lkc-'"),
+					ValidateFunc: validation.StringMatch(regexp.MustCompile("^(lkc-|lsrc-|lksqlc-|(aws|azure|gcp)\\.)"), "the resource ID must be of the form 'lkc-', 'lsrc-', 'lksqlc-' or '<cloud>.<region>'"),
 				},
 				paramKind: {
 					Type:         schema.TypeString,
@@ -427,11 +470,26 @@ func fetchHttpEndpointOfKafkaCluster(ctx context.Context, c *Client, environment
 	}
 }
 
+func validateApiKeyManagedResource(resourceId, resourceKind, resourceApiVersion string) error {
+	managedResource, ok := apiKeyManagedResources[resourceApiVersion]
+	if !ok {
+		return fmt.Errorf("%q=%q is not supported for %q block", paramApiVersion, resourceApiVersion, paramResource)
+	}
+	expectedKind, idPrefix := managedResource[0], managedResource[1]
+	if resourceKind != expectedKind {
+		return fmt.Errorf("%q block with %q=%q must have %q=%q, got %q", paramResource, paramApiVersion, resourceApiVersion, paramKind, expectedKind, resourceKind)
+	}
+	if idPrefix != "" && !strings.HasPrefix(resourceId, idPrefix) {
+		return fmt.Errorf("%q block with %q=%q must have an %q that starts with %q, got %q", paramResource, paramApiVersion, resourceApiVersion, paramId, idPrefix, resourceId)
+	}
+	return nil
+}
+
 func isKafkaApiKey(apiKey apikeys.IamV2ApiKey) bool {
 	return apiKey.Spec.Resource.GetKind() == clusterKind && apiKey.Spec.Resource.GetApiVersion() == cmkApiVersion
 }
 
-func waitForApiKeyToSync(ctx context.Context, c *Client, createdApiKey apikeys.IamV2ApiKey, isResourceSpecificApiKey bool, environmentId string) error {
+func waitForApiKeyToSync(ctx context.Context, c *Client, createdApiKey apikeys.IamV2ApiKey, isResourceSpecificApiKey bool, environmentId string, timeout time.Duration) error {
 	// For Kafka API Key use Kafka REST API's List Topics request and wait for http.StatusOK
 	// For Cloud API Key use Org API's List Environments request and wait for http.StatusOK
 
@@ -443,9 +501,13 @@ func waitForApiKeyToSync(ctx context.Context, c *Client, createdApiKey apikeys.I
 				return fmt.Errorf("error fetching Kafka Cluster %q's %q attribute: %s", clusterId, paramRestEndpoint, createDescriptiveError(err))
 			}
 			kafkaRestClient := c.kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, createdApiKey.GetId(), createdApiKey.Spec.GetSecret(), false)
-			if err := waitForCreatedKafkaApiKeyToSync(ctx, kafkaRestClient); err != nil {
+			if err := waitForCreatedKafkaApiKeyToSync(ctx, kafkaRestClient, timeout); err != nil {
 				return fmt.Errorf("error waiting for Kafka API Key %q to sync: %s", createdApiKey.GetId(), createDescriptiveError(err))
 			}
+		} else if _, ok := apiKeyManagedResources[createdApiKey.Spec.Resource.GetApiVersion()]; ok {
+			// Schema Registry, ksqlDB and Flink API Keys can't be checked without their endpoints, so they might
+			// take a few minutes to start working
+			tflog.Debug(ctx, fmt.Sprintf("Skipping waiting for API Key %q to sync since it's not a Kafka API Key", createdApiKey.GetId()), map[string]interface{}{apiKeyLoggingKey: createdApiKey.GetId()})
 		} else {
 			resourceJson, err := json.Marshal(createdApiKey.Spec.GetResource())
 			if err != nil {
@@ -455,7 +517,7 @@ func waitForApiKeyToSync(ctx context.Context, c *Client, createdApiKey apikeys.I
 		}
 	} else {
 		// Cloud API Key
-		if err := waitForCreatedCloudApiKeyToSync(ctx, c, createdApiKey.GetId(), createdApiKey.Spec.GetSecret()); err != nil {
+		if err := waitForCreatedCloudApiKeyToSync(ctx, c, createdApiKey.GetId(), createdApiKey.Spec.GetSecret(), timeout); err != nil {
 			return fmt.Errorf("error waiting for Cloud API Key %q to sync: %s", createdApiKey.GetId(), createDescriptiveError(err))
 		}
 	}
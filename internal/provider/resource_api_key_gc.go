@@ -0,0 +1,243 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	apikeys "github.com/confluentinc/ccloud-sdk-go-v2/apikeys/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	paramDisplayNamePrefix = "display_name_prefix"
+	paramTtl               = "ttl"
+	paramExpiredApiKeyIds  = "expired_api_key_ids"
+
+	// The maximum allowable page size - 1 (to avoid off-by-one errors) when listing API keys using API Keys V2 API
+	// https://docs.confluent.io/cloud/current/api.html#operation/listIamV2ApiKeys
+	listApiKeysPageSize = 99
+
+	apiKeyGcLoggingKey = "api_key_gc_id"
+)
+
+func apiKeyGcResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: apiKeyGcCreate,
+		ReadContext:   apiKeyGcRead,
+		UpdateContext: apiKeyGcUpdate,
+		DeleteContext: apiKeyGcDelete,
+		CustomizeDiff: apiKeyGcCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			paramDisplayNamePrefix: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The prefix of the display names of API keys to delete.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramTtl: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The age after which API keys are deleted (e.g., `24h`).",
+				ValidateFunc: validatePositiveDuration,
+			},
+			paramExpiredApiKeyIds: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of expired API keys that will be deleted on the next apply.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultResourceTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Update: schema.DefaultTimeout(defaultResourceTimeout),
+			Delete: schema.DefaultTimeout(defaultResourceTimeout),
+		},
+	}
+}
+
+func apiKeyGcCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get(paramDisplayNamePrefix).(string))
+	tflog.Debug(ctx, fmt.Sprintf("Creating new API Key GC %q", d.Id()), map[string]interface{}{apiKeyGcLoggingKey: d.Id()})
+
+	// No plan has listed the expired API keys yet, so they are only listed now and deleted on the next apply
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating API Key GC %q", d.Id()), map[string]interface{}{apiKeyGcLoggingKey: d.Id()})
+
+	return apiKeyGcRead(ctx, d, meta)
+}
+
+func apiKeyGcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading API Key GC %q", d.Id()), map[string]interface{}{apiKeyGcLoggingKey: d.Id()})
+
+	expiredApiKeys, err := loadExpiredApiKeys(ctx, meta.(*Client), d.Get(paramDisplayNamePrefix).(string), d.Get(paramTtl).(string))
+	if err != nil {
+		return diag.Errorf("error reading API Key GC %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	expiredApiKeyIds := make([]string, len(expiredApiKeys))
+	for i, expiredApiKey := range expiredApiKeys {
+		expiredApiKeyIds[i] = expiredApiKey.GetId()
+	}
+	if err := d.Set(paramExpiredApiKeyIds, expiredApiKeyIds); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading API Key GC %q: found %d expired API Keys", d.Id(), len(expiredApiKeyIds)), map[string]interface{}{apiKeyGcLoggingKey: d.Id()})
+
+	return nil
+}
+
+// Plans an update that deletes expired API keys whenever the refresh found some
+func apiKeyGcCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	// The refresh listed the expired API keys with the old TTL, so they are listed again with the new TTL
+	// instead of being deleted, for example, when the TTL is raised to keep API keys
+	if diff.HasChange(paramTtl) {
+		return diff.SetNewComputed(paramExpiredApiKeyIds)
+	}
+	if len(diff.Get(paramExpiredApiKeyIds).([]interface{})) > 0 {
+		return diff.SetNew(paramExpiredApiKeyIds, []string{})
+	}
+	return nil
+}
+
+func apiKeyGcUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Updating API Key GC %q", d.Id()), map[string]interface{}{apiKeyGcLoggingKey: d.Id()})
+
+	if d.HasChange(paramTtl) {
+		tflog.Debug(ctx, fmt.Sprintf("Finished updating API Key GC %q: %q has changed, so expired API Keys will be deleted on the next apply", d.Id(), paramTtl), map[string]interface{}{apiKeyGcLoggingKey: d.Id()})
+		return apiKeyGcRead(ctx, d, meta)
+	}
+
+	// Only the expired API keys that the plan shows are deleted, API keys that have expired since then are deleted on the next apply
+	plannedApiKeyIds, _ := d.GetChange(paramExpiredApiKeyIds)
+	if err := deleteExpiredApiKeys(ctx, meta.(*Client), convertToStringSlice(plannedApiKeyIds.([]interface{}))); err != nil {
+		return diag.Errorf("error updating API Key GC %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	// Don't read the expired API keys again to match the plan
+	if err := d.Set(paramExpiredApiKeyIds, []string{}); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished updating API Key GC %q", d.Id()), map[string]interface{}{apiKeyGcLoggingKey: d.Id()})
+
+	return nil
+}
+
+func apiKeyGcDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// API keys are never deleted when the API Key GC itself is deleted
+	tflog.Debug(ctx, fmt.Sprintf("Deleting API Key GC %q", d.Id()), map[string]interface{}{apiKeyGcLoggingKey: d.Id()})
+	return nil
+}
+
+func deleteExpiredApiKeys(ctx context.Context, c *Client, expiredApiKeyIds []string) error {
+	for i, expiredApiKeyId := range expiredApiKeyIds {
+		// Never delete the Cloud API Key the provider uses
+		if expiredApiKeyId == c.cloudApiKey {
+			continue
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Deleting expired API Key %q (%d of %d)", expiredApiKeyId, i+1, len(expiredApiKeyIds)), map[string]interface{}{apiKeyLoggingKey: expiredApiKeyId})
+		resp, err := c.apiKeysClient.APIKeysIamV2Api.DeleteIamV2ApiKey(c.apiKeysApiContext(ctx), expiredApiKeyId).Execute()
+		// The API key might have been deleted by someone else in the meantime
+		if err != nil && !(resp != nil && resp.StatusCode == http.StatusNotFound) {
+			return fmt.Errorf("error deleting expired API Key %q (%d of %d expired API Keys have been deleted): %s", expiredApiKeyId, i, len(expiredApiKeyIds), createDescriptiveError(err))
+		}
+	}
+	return nil
+}
+
+func loadExpiredApiKeys(ctx context.Context, c *Client, displayNamePrefix, ttl string) ([]apikeys.IamV2ApiKey, error) {
+	ttlDuration, err := time.ParseDuration(ttl)
+	if err != nil {
+		return nil, err
+	}
+	apiKeys, err := loadApiKeys(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	expiredApiKeys := make([]apikeys.IamV2ApiKey, 0)
+	now := time.Now()
+	for _, apiKey := range apiKeys {
+		// Never delete the Cloud API Key the provider uses
+		if apiKey.GetId() == c.cloudApiKey {
+			continue
+		}
+		if isExpiredApiKey(apiKey, displayNamePrefix, ttlDuration, now) {
+			expiredApiKeys = append(expiredApiKeys, apiKey)
+		}
+	}
+	return expiredApiKeys, nil
+}
+
+func isExpiredApiKey(apiKey apikeys.IamV2ApiKey, displayNamePrefix string, ttl time.Duration, now time.Time) bool {
+	if !strings.HasPrefix(apiKey.Spec.GetDisplayName(), displayNamePrefix) {
+		return false
+	}
+	createdAt := apiKey.Metadata.GetCreatedAt()
+	if createdAt.IsZero() {
+		return false
+	}
+	return now.Sub(createdAt) > ttl
+}
+
+func loadApiKeys(ctx context.Context, c *Client) ([]apikeys.IamV2ApiKey, error) {
+	apiKeys := make([]apikeys.IamV2ApiKey, 0)
+
+	allApiKeysAreCollected := false
+	pageToken := ""
+	for !allApiKeysAreCollected {
+		apiKeyPageList, _, err := executeListApiKeys(ctx, c, pageToken)
+		if err != nil {
+			return nil, fmt.Errorf("error reading API Keys: %s", createDescriptiveError(err))
+		}
+		apiKeys = append(apiKeys, apiKeyPageList.GetData()...)
+
+		// nextPageUrlStringNullable is nil for the last page
+		nextPageUrlStringNullable := apiKeyPageList.GetMetadata().Next
+
+		if nextPageUrlStringNullable.IsSet() {
+			nextPageUrlString := *nextPageUrlStringNullable.Get()
+			if nextPageUrlString == "" {
+				allApiKeysAreCollected = true
+			} else {
+				pageToken, err = extractPageToken(nextPageUrlString)
+				if err != nil {
+					return nil, fmt.Errorf("error reading API Keys: %s", createDescriptiveError(err))
+				}
+			}
+		} else {
+			allApiKeysAreCollected = true
+		}
+	}
+	return apiKeys, nil
+}
+
+func executeListApiKeys(ctx context.Context, c *Client, pageToken string) (apikeys.IamV2ApiKeyList, *http.Response, error) {
+	if pageToken != "" {
+		return c.apiKeysClient.APIKeysIamV2Api.ListIamV2ApiKeys(c.apiKeysApiContext(ctx)).PageSize(listApiKeysPageSize).PageToken(pageToken).Execute()
+	} else {
+		return c.apiKeysClient.APIKeysIamV2Api.ListIamV2ApiKeys(c.apiKeysApiContext(ctx)).PageSize(listApiKeysPageSize).Execute()
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	apikeys "github.com/confluentinc/ccloud-sdk-go-v2/apikeys/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApiKeyGcDeletesPlannedApiKeysOnly(t *testing.T) {
+	var deletedApiKeyIds []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodDelete {
+			deletedApiKeyIds = append(deletedApiKeyIds, strings.TrimPrefix(r.URL.Path, "/iam/v2/api-keys/"))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		// EXPIRED2 has expired since the plan was made
+		_, _ = w.Write([]byte(`{"data": [
+			{"id": "EXPIRED1", "spec": {"display_name": "ci-test-1"}, "metadata": {"created_at": "2020-01-01T00:00:00Z"}},
+			{"id": "EXPIRED2", "spec": {"display_name": "ci-test-2"}, "metadata": {"created_at": "2020-01-01T00:00:00Z"}}
+		], "metadata": {}}`))
+	}))
+	defer server.Close()
+	apiKeysCfg := apikeys.NewConfiguration()
+	apiKeysCfg.Servers[0].URL = server.URL
+	apiKeysCfg.HTTPClient = createRetryableHttpClient(defaultHttpClientSettings())
+	client := &Client{apiKeysClient: apikeys.NewAPIClient(apiKeysCfg), cloudApiKey: "PROVIDERAPIKEY00", cloudApiSecret: "secret"}
+
+	// Creating the resource only lists the expired API Keys
+	d := schema.TestResourceDataRaw(t, apiKeyGcResource().Schema, map[string]interface{}{
+		paramDisplayNamePrefix: "ci-test-",
+		paramTtl:               "24h",
+	})
+	if diags := apiKeyGcCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if len(deletedApiKeyIds) != 0 {
+		t.Fatalf("Expected no API Keys to be deleted on create, got %v", deletedApiKeyIds)
+	}
+	if expiredApiKeyIds := d.Get(paramExpiredApiKeyIds).([]interface{}); len(expiredApiKeyIds) != 2 {
+		t.Fatalf("Expected 2 expired API Keys to be listed, got %v", expiredApiKeyIds)
+	}
+
+	// The plan listed EXPIRED1 and the Cloud API Key of the provider only
+	d = apiKeyGcResource().Data(&terraform.InstanceState{
+		ID: "ci-test-",
+		Attributes: map[string]string{
+			paramDisplayNamePrefix:       "ci-test-",
+			paramTtl:                     "24h",
+			paramExpiredApiKeyIds + ".#": "2",
+			paramExpiredApiKeyIds + ".0": "EXPIRED1",
+			paramExpiredApiKeyIds + ".1": "PROVIDERAPIKEY00",
+		},
+	})
+	if diags := apiKeyGcUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if !reflect.DeepEqual(deletedApiKeyIds, []string{"EXPIRED1"}) {
+		t.Fatalf("Expected only the planned API Key EXPIRED1 to be deleted, got %v", deletedApiKeyIds)
+	}
+}
+
+func TestApiKeyGcKeepsApiKeysWhenTtlChanges(t *testing.T) {
+	var deletedApiKeyIds []string
+	createdAt := time.Now().Add(-48 * time.Hour).UTC().Format(time.RFC3339)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodDelete {
+			deletedApiKeyIds = append(deletedApiKeyIds, strings.TrimPrefix(r.URL.Path, "/iam/v2/api-keys/"))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": [
+			{"id": "RECENT1", "spec": {"display_name": "ci-test-1"}, "metadata": {"created_at": "` + createdAt + `"}}
+		], "metadata": {}}`))
+	}))
+	defer server.Close()
+	apiKeysCfg := apikeys.NewConfiguration()
+	apiKeysCfg.Servers[0].URL = server.URL
+	apiKeysCfg.HTTPClient = createRetryableHttpClient(defaultHttpClientSettings())
+	client := &Client{apiKeysClient: apikeys.NewAPIClient(apiKeysCfg), cloudApiKey: "PROVIDERAPIKEY00", cloudApiSecret: "secret"}
+
+	// RECENT1 was refreshed as expired with the old TTL, and the TTL is raised to keep it
+	state := &terraform.InstanceState{
+		ID: "ci-test-",
+		Attributes: map[string]string{
+			"id":                         "ci-test-",
+			paramDisplayNamePrefix:       "ci-test-",
+			paramTtl:                     "24h",
+			paramExpiredApiKeyIds + ".#": "1",
+			paramExpiredApiKeyIds + ".0": "RECENT1",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		paramDisplayNamePrefix: "ci-test-",
+		paramTtl:               "720h",
+	})
+	r := apiKeyGcResource()
+	instanceDiff, err := r.Diff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	newState, diags := r.Apply(context.Background(), state, instanceDiff, client)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if len(deletedApiKeyIds) != 0 {
+		t.Fatalf("Expected no API Keys to be deleted when %q changes, got %v", paramTtl, deletedApiKeyIds)
+	}
+	if newState.Attributes[paramTtl] != "720h" || newState.Attributes[paramExpiredApiKeyIds+".#"] != "0" {
+		t.Fatalf("Expected the expired API Keys to be listed with the new TTL, got %v", newState.Attributes)
+	}
+}
+
+func TestIsExpiredApiKey(t *testing.T) {
+	now := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	newApiKey := func(displayName string, createdAt time.Time) apikeys.IamV2ApiKey {
+		return apikeys.IamV2ApiKey{
+			Spec:     &apikeys.IamV2ApiKeySpec{DisplayName: &displayName},
+			Metadata: &apikeys.ObjectMeta{CreatedAt: &createdAt},
+		}
+	}
+	if !isExpiredApiKey(newApiKey("ci-test-123", now.Add(-25*time.Hour)), "ci-test-", 24*time.Hour, now) {
+		t.Fatalf("expected an old API Key with a matching display name to be expired")
+	}
+	if isExpiredApiKey(newApiKey("ci-test-123", now.Add(-time.Hour)), "ci-test-", 24*time.Hour, now) {
+		t.Fatalf("expected a new API Key not to be expired")
+	}
+	if isExpiredApiKey(newApiKey("prod-123", now.Add(-25*time.Hour)), "ci-test-", 24*time.Hour, now) {
+		t.Fatalf("expected an API Key with another display name not to be expired")
+	}
+	if isExpiredApiKey(apikeys.IamV2ApiKey{}, "", 24*time.Hour, now) {
+		t.Fatalf("expected an API Key without a creation time not to be expired")
+	}
+}
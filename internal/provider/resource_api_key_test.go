@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	apikeys "github.com/confluentinc/ccloud-sdk-go-v2/apikeys/v2"
 	"github.com/docker/go-connections/nat"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
@@ -25,10 +26,12 @@ import (
 	"github.com/walkerus/go-wiremock"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -576,3 +579,73 @@ func testAccCheckApiKeyExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestValidateApiKeyManagedResource(t *testing.T) {
+	for _, managedResource := range [][3]string{
+		{"lkc-abc123", clusterKind, cmkApiVersion},
+		{"lsrc-abc123", clusterKind, srcmApiVersion},
+		{"lksqlc-abc123", clusterKind, ksqldbcmApiVersion},
+		{"aws.us-east-1", regionKind, fcpmApiVersion},
+	} {
+		if err := validateApiKeyManagedResource(managedResource[0], managedResource[1], managedResource[2]); err != nil {
+			t.Fatalf("Unexpected error for %v: %s", managedResource, err)
+		}
+	}
+	for _, managedResource := range [][3]string{
+		{"lkc-abc123", clusterKind, srcmApiVersion},
+		{"aws.us-east-1", clusterKind, fcpmApiVersion},
+		{"lkc-abc123", clusterKind, "org/v2"},
+	} {
+		if err := validateApiKeyManagedResource(managedResource[0], managedResource[1], managedResource[2]); err == nil {
+			t.Fatalf("Expected an error for %v", managedResource)
+		}
+	}
+}
+
+func TestApiKeyKeepersForceNew(t *testing.T) {
+	keepersSchema, ok := apiKeyResource().Schema[paramKeepers]
+	if !ok || !keepersSchema.ForceNew || !keepersSchema.Optional {
+		t.Fatalf("Expected %q to be an optional argument that recreates the API Key", paramKeepers)
+	}
+}
+
+func TestApiKeyCreateSendsEnvironmentOfFlinkRegion(t *testing.T) {
+	var createApiKeyRequest apikeys.IamV2ApiKey
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			if err := json.NewDecoder(r.Body).Decode(&createApiKeyRequest); err != nil {
+				t.Errorf("Failed to decode the create request: %s", err)
+			}
+		}
+		_, _ = fmt.Fprint(w, `{"id": "FLINKAPIKEY00001", "spec": {"secret": "secret", "display_name": "flink", "owner": {"id": "sa-abc123", "kind": "ServiceAccount", "api_version": "iam/v2"}, "resource": {"id": "aws.us-east-1", "kind": "Region", "api_version": "fcpm/v2", "environment": "env-abc123"}}}`)
+	}))
+	defer server.Close()
+
+	apiKeysCfg := apikeys.NewConfiguration()
+	apiKeysCfg.Servers[0].URL = server.URL
+	apiKeysCfg.HTTPClient = createRetryableHttpClient(defaultHttpClientSettings())
+	client := &Client{apiKeysClient: apikeys.NewAPIClient(apiKeysCfg), cloudApiKey: "PROVIDERAPIKEY00", cloudApiSecret: "secret"}
+
+	d := schema.TestResourceDataRaw(t, apiKeyResource().Schema, map[string]interface{}{
+		paramDisplayName:         "flink",
+		paramDisableWaitForReady: true,
+		paramOwner: []interface{}{map[string]interface{}{
+			paramId:         "sa-abc123",
+			paramKind:       serviceAccountKind,
+			paramApiVersion: iamApiVersion,
+		}},
+		paramResource: []interface{}{map[string]interface{}{
+			paramId:          "aws.us-east-1",
+			paramKind:        regionKind,
+			paramApiVersion:  fcpmApiVersion,
+			paramEnvironment: []interface{}{map[string]interface{}{paramId: "env-abc123"}},
+		}},
+	})
+	if diags := apiKeyCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("Unexpected error creating the API Key: %v", diags)
+	}
+	if got := createApiKeyRequest.Spec.Resource.GetEnvironment(); got != "env-abc123" {
+		t.Fatalf("Expected the create request to reference Environment %q, got %q", "env-abc123", got)
+	}
+}
@@ -31,6 +31,7 @@ import (
 
 const (
 	connectAPICreateTimeout   = 24 * time.Hour
+	connectAPIUpdateTimeout   = 1 * time.Hour
 	connectAPIWaitAfterCreate = 5 * time.Second
 
 	paramSensitiveConfig    = "config_sensitive"
@@ -70,7 +71,7 @@ func connectorResource() *schema.Resource {
 			StateContext: connectorImport,
 		},
 		Schema: map[string]*schema.Schema{
-			paramEnvironment:  environmentSchema(),
+			paramEnvironment:  environmentSchemaWithDefault(),
 			paramKafkaCluster: kafkaClusterBlockSchema(),
 			paramStatus: {
 				Type:     schema.TypeString,
@@ -99,6 +100,9 @@ func connectorResource() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(connectAPICreateTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Update: schema.DefaultTimeout(connectAPIUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultResourceTimeout),
 		},
 	}
 }
@@ -152,7 +156,7 @@ func connectorCreate(ctx context.Context, d *schema.ResourceData, meta interface
 	}
 	d.SetId(createdConnectorWithId.Id.GetId())
 
-	if err := waitForConnectorToProvision(c.connectApiContext(ctx), c, displayName, environmentId, clusterId); err != nil {
+	if err := waitForConnectorToProvision(c.connectApiContext(ctx), c, displayName, environmentId, clusterId, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("error waiting for Connector %q to provision: %s", displayName, createDescriptiveError(err))
 	}
 
@@ -266,7 +270,7 @@ func setConnectorAttributes(d *schema.ResourceData, connector connect.ConnectV1C
 
 func connectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChangesExcept(paramNonSensitiveConfig, paramSensitiveConfig, paramStatus) {
-		return diag.Errorf("error updating Connector %q: only %q attribute, %q and %q blocks can be updated for Connector", d.Id(), paramStatus, paramNonSensitiveConfig, paramSensitiveConfig)
+		return nonUpdatableAttributeErrors(d, connectorResource().Schema, fmt.Sprintf("error updating Connector %q: only %q attribute, %q and %q blocks can be updated for Connector", d.Id(), paramStatus, paramNonSensitiveConfig, paramSensitiveConfig), paramNonSensitiveConfig, paramSensitiveConfig, paramStatus)
 	}
 	c := meta.(*Client)
 	displayName := d.Get(connectorConfigFullAttributeName).(string)
@@ -289,7 +293,7 @@ func connectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface
 			if err != nil {
 				return diag.Errorf("error updating Connector %q: %s", d.Id(), createDescriptiveError(err))
 			}
-			if err := waitForConnectorToChangeStatus(c.connectApiContext(ctx), c, displayName, environmentId, clusterId, stateRunning, statePaused); err != nil {
+			if err := waitForConnectorToChangeStatus(c.connectApiContext(ctx), c, displayName, environmentId, clusterId, stateRunning, statePaused, d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return diag.Errorf("error waiting for Connector %q to be updated: %s", d.Id(), createDescriptiveError(err))
 			}
 		} else if shouldResumeConnector {
@@ -300,7 +304,7 @@ func connectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface
 			if err != nil {
 				return diag.Errorf("error updating Connector %q: %s", d.Id(), createDescriptiveError(err))
 			}
-			if err := waitForConnectorToChangeStatus(c.connectApiContext(ctx), c, displayName, environmentId, clusterId, statePaused, stateRunning); err != nil {
+			if err := waitForConnectorToChangeStatus(c.connectApiContext(ctx), c, displayName, environmentId, clusterId, statePaused, stateRunning, d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return diag.Errorf("error waiting for Connector %q to be updated: %s", d.Id(), createDescriptiveError(err))
 			}
 		} else {
@@ -48,6 +48,12 @@ func environmentResource() *schema.Resource {
 				Description: "The Confluent Resource Name of the Environment.",
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultResourceTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Update: schema.DefaultTimeout(defaultResourceTimeout),
+			Delete: schema.DefaultTimeout(defaultResourceTimeout),
+		},
 	}
 }
 
@@ -39,13 +39,48 @@ const (
 	paramOperation    = "operation"
 	paramPermission   = "permission"
 
+	paramForceDeleteMultiple = "force_delete_multiple"
+
 	principalPrefix = "User:"
+	// Matches all authenticated users
+	wildcardPrincipal = "User:*"
+	// Identity pools are used by OAuth clients
+	identityPoolPrincipalPrefix = "User:pool-"
+	// Matches all hosts
+	wildcardHost = "*"
+
+	// Based on the tests, Kafka ACLs take a few seconds to sync
+	kafkaAclSyncTimeout = 15 * time.Minute
 )
 
-var acceptedResourceTypes = []string{"UNKNOWN", "ANY", "TOPIC", "GROUP", "CLUSTER", "TRANSACTIONAL_ID", "DELEGATION_TOKEN"}
-var acceptedPatternTypes = []string{"UNKNOWN", "ANY", "MATCH", "LITERAL", "PREFIXED"}
-var acceptedOperations = []string{"UNKNOWN", "ANY", "ALL", "READ", "WRITE", "CREATE", "DELETE", "ALTER", "DESCRIBE", "CLUSTER_ACTION", "DESCRIBE_CONFIGS", "ALTER_CONFIGS", "IDEMPOTENT_WRITE"}
-var acceptedPermissions = []string{"UNKNOWN", "ANY", "DENY", "ALLOW"}
+//go:generate go run ../tools/genaclenums -output acl_enums_generated.go
+
+// Operations that Kafka REST API accepts but that are missing in the enums of the SDK the provider is built against
+var extraAclOperations = []string{"CREATE_TOKENS", "DESCRIBE_TOKENS"}
+
+// Resource types that Kafka REST API accepts but that are missing in the enums of the SDK, USER ACLs
+// allow creating and describing delegation tokens on behalf of other users
+var extraAclResourceTypes = []string{"USER"}
+
+// The accepted values are generated from the SDK enums (see acl_enums_generated.go) so that new values
+// are accepted as soon as the SDK is upgraded
+var acceptedResourceTypes = appendMissingValues(sdkAclResourceTypes, extraAclResourceTypes)
+var acceptedPatternTypes = sdkAclPatternTypes
+var acceptedOperations = appendMissingValues(sdkAclOperations, extraAclOperations)
+var acceptedPermissions = sdkAclPermissions
+
+var principalWithIntegerIdRegex = regexp.MustCompile(`^User:\d+$`)
+
+// Operations that the broker accepts for each resource type, see
+// https://docs.confluent.io/platform/current/kafka/authorization.html#operations
+// Resource types that are missing (e.g., ANY) are not validated.
+var acceptedOperationsPerResourceType = map[string][]string{
+	"TOPIC":            {"ALL", "READ", "WRITE", "CREATE", "DELETE", "ALTER", "DESCRIBE", "DESCRIBE_CONFIGS", "ALTER_CONFIGS"},
+	"GROUP":            {"ALL", "READ", "DELETE", "DESCRIBE"},
+	"CLUSTER":          {"ALL", "CREATE", "ALTER", "DESCRIBE", "CLUSTER_ACTION", "DESCRIBE_CONFIGS", "ALTER_CONFIGS", "IDEMPOTENT_WRITE"},
+	"TRANSACTIONAL_ID": {"ALL", "WRITE", "DESCRIBE"},
+	"USER":             {"ALL", "CREATE_TOKENS", "DESCRIBE_TOKENS"},
+}
 
 func extractAcl(d *schema.ResourceData) (Acl, error) {
 	resourceType, err := stringToAclResourceType(d.Get(paramResourceType).(string))
@@ -69,12 +104,32 @@ func extractAcl(d *schema.ResourceData) (Acl, error) {
 		ResourceName: d.Get(paramResourceName).(string),
 		PatternType:  patternType,
 		Principal:    d.Get(paramPrincipal).(string),
-		Host:         d.Get(paramHost).(string),
+		Host:         normalizeAclHost(d.Get(paramHost).(string)),
 		Operation:    operation,
 		Permission:   permission,
 	}, nil
 }
 
+var cloudKafkaAclPrincipalRegex = regexp.MustCompile(`^User:((sa|u|pool)-|\*$)`)
+
+// Confluent Platform principals are not limited to Confluent Cloud accounts, so only the loose form is validated here
+// and principals of Confluent Cloud clusters are checked by validateKafkaAclPrincipalForCluster once the provider is configured
+var validateKafkaAclPrincipal = validation.StringMatch(regexp.MustCompile(`^(User|Group):.+`), "the principal must start with 'User:' or 'Group:'")
+
+// Principals of Confluent Cloud clusters must start with 'User:sa-', 'User:u-' or 'User:pool-' or be equal to 'User:*',
+// other principals are accepted for self-managed Kafka clusters only
+func validateKafkaAclPrincipalForCluster(c *Client, principal string) error {
+	// principal is empty when its value is not known until apply
+	if principal == "" || cloudKafkaAclPrincipalRegex.MatchString(principal) {
+		return nil
+	}
+	// The provider is not configured yet, for example, during validation
+	if c == nil || c.isSelfManagedKafka() {
+		return nil
+	}
+	return fmt.Errorf("the principal must start with 'User:sa-', 'User:u-' or 'User:pool-' or be equal to 'User:*', got %q: other principals are only accepted for self-managed Kafka clusters", principal)
+}
+
 func kafkaAclResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: kafkaAclCreate,
@@ -84,8 +139,9 @@ func kafkaAclResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: kafkaAclImport,
 		},
+		CustomizeDiff: kafkaAclCustomizeDiff,
 		Schema: map[string]*schema.Schema{
-			paramKafkaCluster: kafkaClusterBlockSchema(),
+			paramKafkaCluster: optionalKafkaClusterBlockSchema(),
 			paramResourceType: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -111,13 +167,15 @@ func kafkaAclResource() *schema.Resource {
 				Required:     true,
 				ForceNew:     true,
 				Description:  "The principal for the ACL.",
-				ValidateFunc: validation.StringMatch(regexp.MustCompile("^User:(sa|u)-"), "the principal must start with 'User:sa-' or 'User:u-'."),
+				ValidateFunc: validateKafkaAclPrincipal,
 			},
 			paramHost: {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The host for the ACL.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          wildcardHost,
+				Description:      "The host for the ACL.",
+				DiffSuppressFunc: aclHostDiffSuppressFunc,
 			},
 			paramOperation: {
 				Type:         schema.TypeString,
@@ -141,6 +199,18 @@ func kafkaAclResource() *schema.Resource {
 				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
 			},
 			paramCredentials: credentialsSchema(),
+			paramForceDeleteMultiple: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to delete Kafka ACLs even if more than one Kafka ACL matches the filter built from the attributes of this resource.",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(kafkaAclSyncTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Update: schema.DefaultTimeout(defaultResourceTimeout),
+			Delete: schema.DefaultTimeout(kafkaAclSyncTimeout),
 		},
 		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
@@ -163,8 +233,11 @@ func kafkaAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{
 	if err != nil {
 		return diag.Errorf("error creating Kafka ACLs: %s", createDescriptiveError(err))
 	}
-	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
-	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error creating Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, clusterId, false)
 	if err != nil {
 		return diag.Errorf("error creating Kafka ACLs: %s", createDescriptiveError(err))
 	}
@@ -194,6 +267,7 @@ func kafkaAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Creating new Kafka ACLs: %s", createAclRequestJson))
 
+	// Kafka creates ACLs idempotently, so re-running an interrupted create adopts the ACL created before
 	_, err = executeKafkaAclCreate(ctx, kafkaRestClient, createAclRequest)
 
 	if err != nil {
@@ -203,7 +277,9 @@ func kafkaAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{
 	d.SetId(kafkaAclId)
 
 	// https://github.com/confluentinc/terraform-provider-confluent/issues/40#issuecomment-1048782379
-	time.Sleep(kafkaRestAPIWaitAfterCreate)
+	if err := waitForCreatedKafkaAclToSync(ctx, kafkaRestClient, acl, principalWithIntegerId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Kafka ACLs %q to sync: %s", d.Id(), createDescriptiveError(err))
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Finished creating Kafka ACLs %q", d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
 
@@ -224,8 +300,11 @@ func kafkaAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{
 	if err != nil {
 		return diag.Errorf("error deleting Kafka ACLs: %s", createDescriptiveError(err))
 	}
-	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
-	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, clusterId, false)
 	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaMetadataSet)
 
 	acl, err := extractAcl(d)
@@ -240,31 +319,67 @@ func kafkaAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{
 		return diag.FromErr(createDescriptiveError(err))
 	}
 
-	opts := &kafkarestv3.DeleteKafkaV3AclsOpts{
-		ResourceType: optional.NewInterface(acl.ResourceType),
-		ResourceName: optional.NewString(acl.ResourceName),
-		PatternType:  optional.NewInterface(acl.PatternType),
-		Principal:    optional.NewString(principalWithIntegerId),
-		Host:         optional.NewString(acl.Host),
-		Operation:    optional.NewInterface(acl.Operation),
-		Permission:   optional.NewInterface(acl.Permission),
+	// DeleteKafkaV3Acls uses filter semantics so make sure it's not going to delete more ACLs than expected
+	if !d.Get(paramForceDeleteMultiple).(bool) {
+		matchedAcls, _, err := executeKafkaAclRead(ctx, kafkaRestClient, createGetKafkaV3AclsOpts(acl, principalWithIntegerId))
+		if err != nil {
+			return diag.Errorf("error deleting Kafka ACLs %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		if len(matchedAcls.Data) > 1 {
+			matchedAclsJson, err := json.Marshal(matchedAcls.Data)
+			if err != nil {
+				return diag.Errorf("error deleting Kafka ACLs %q: error marshaling %#v to json: %s", d.Id(), matchedAcls.Data, createDescriptiveError(err))
+			}
+			return diag.Errorf("error deleting Kafka ACLs %q: %d Kafka ACLs were matched: %s. "+
+				"Set %q attribute to true to delete all of them", d.Id(), len(matchedAcls.Data), matchedAclsJson, paramForceDeleteMultiple)
+		}
 	}
 
-	_, _, err = kafkaRestClient.apiClient.ACLV3Api.DeleteKafkaV3Acls(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId, opts)
+	_, _, err = executeKafkaAclDelete(ctx, kafkaRestClient, acl, principalWithIntegerId)
 
 	if err != nil {
 		return diag.Errorf("error deleting Kafka ACLs %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
+	// Recreating an identical Kafka ACL in the same apply might race with the deletion otherwise
+	if err := waitForKafkaAclToBeDeleted(ctx, kafkaRestClient, acl, principalWithIntegerId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Kafka ACLs %q to be deleted: %s", d.Id(), createDescriptiveError(err))
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Kafka ACLs %q", d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
 
 	return nil
 }
 
+func executeKafkaAclDelete(ctx context.Context, c *KafkaRestClient, acl Acl, principalWithIntegerId string) (kafkarestv3.InlineResponse200, *http.Response, error) {
+	opts := &kafkarestv3.DeleteKafkaV3AclsOpts{
+		ResourceType: optional.NewInterface(acl.ResourceType),
+		ResourceName: optional.NewString(acl.ResourceName),
+		PatternType:  optional.NewInterface(acl.PatternType),
+		Principal:    optional.NewString(principalWithIntegerId),
+		Host:         optional.NewString(acl.Host),
+		Operation:    optional.NewInterface(acl.Operation),
+		Permission:   optional.NewInterface(acl.Permission),
+	}
+	return c.apiClient.ACLV3Api.DeleteKafkaV3Acls(c.apiContext(ctx), c.clusterId, opts)
+}
+
 func executeKafkaAclRead(ctx context.Context, c *KafkaRestClient, opts *kafkarestv3.GetKafkaV3AclsOpts) (kafkarestv3.AclDataList, *http.Response, error) {
 	return c.apiClient.ACLV3Api.GetKafkaV3Acls(c.apiContext(ctx), c.clusterId, opts)
 }
 
+func createGetKafkaV3AclsOpts(acl Acl, principalWithIntegerId string) *kafkarestv3.GetKafkaV3AclsOpts {
+	return &kafkarestv3.GetKafkaV3AclsOpts{
+		ResourceType: optional.NewInterface(acl.ResourceType),
+		ResourceName: optional.NewString(acl.ResourceName),
+		PatternType:  optional.NewInterface(acl.PatternType),
+		Principal:    optional.NewString(principalWithIntegerId),
+		Host:         optional.NewString(acl.Host),
+		Operation:    optional.NewInterface(acl.Operation),
+		Permission:   optional.NewInterface(acl.Permission),
+	}
+}
+
 func kafkaAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka ACLs %q", d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
 
@@ -272,8 +387,11 @@ func kafkaAclRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
 	}
-	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
-	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, clusterId, false)
 	if err != nil {
 		return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
 	}
@@ -289,7 +407,12 @@ func kafkaAclRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 	// This hack is necessary since terraform plan will use the principal's value (integerId) from terraform.state
 	// instead of using the new provided resourceId from main.tf (the user will be forced to replace integerId with resourceId
 	// that we have an input validation for using "User:sa-" for principal attribute.
-	if !(strings.HasPrefix(acl.Principal, "User:sa-") || strings.HasPrefix(acl.Principal, "User:u-")) {
+	// It's opt-in to avoid wiping legitimate state.
+	if principalWithIntegerIdRegex.MatchString(acl.Principal) {
+		if !client.dropKafkaAclsWithIntegerIdPrincipals {
+			return diag.Errorf("error reading Kafka ACLs %q: principal %q uses an integer ID, set `drop_kafka_acls_with_integer_id_principals = true` in the provider block to remove it from the state and create it again with a principal with a resource ID", d.Id(), acl.Principal)
+		}
+		tflog.Warn(ctx, fmt.Sprintf("Removing Kafka ACLs %q from the state since its principal uses an integer ID", d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
 		d.SetId("")
 		return nil
 	}
@@ -323,17 +446,7 @@ func readAclAndSetAttributes(ctx context.Context, d *schema.ResourceData, client
 		return nil, err
 	}
 
-	opts := &kafkarestv3.GetKafkaV3AclsOpts{
-		ResourceType: optional.NewInterface(acl.ResourceType),
-		ResourceName: optional.NewString(acl.ResourceName),
-		PatternType:  optional.NewInterface(acl.PatternType),
-		Principal:    optional.NewString(principalWithIntegerId),
-		Host:         optional.NewString(acl.Host),
-		Operation:    optional.NewInterface(acl.Operation),
-		Permission:   optional.NewInterface(acl.Permission),
-	}
-
-	remoteAcls, resp, err := executeKafkaAclRead(ctx, c, opts)
+	remoteAcls, resp, err := executeKafkaAclRead(ctx, c, createGetKafkaV3AclsOpts(acl, principalWithIntegerId))
 	if err != nil {
 		tflog.Warn(ctx, fmt.Sprintf("Error reading Kafka ACLs %q: %s", d.Id(), createDescriptiveError(err)), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
 
@@ -375,7 +488,7 @@ func readAclAndSetAttributes(ctx context.Context, d *schema.ResourceData, client
 	if err := d.Set(paramPrincipal, acl.Principal); err != nil {
 		return nil, err
 	}
-	if err := d.Set(paramHost, matchedAcl.Host); err != nil {
+	if err := d.Set(paramHost, normalizeAclHost(matchedAcl.Host)); err != nil {
 		return nil, err
 	}
 	if err := d.Set(paramOperation, matchedAcl.Operation); err != nil {
@@ -384,9 +497,15 @@ func readAclAndSetAttributes(ctx context.Context, d *schema.ResourceData, client
 	if err := d.Set(paramPermission, matchedAcl.Permission); err != nil {
 		return nil, err
 	}
+	// Explicitly set the default value to avoid a diff after importing Kafka ACLs
+	if err := d.Set(paramForceDeleteMultiple, d.Get(paramForceDeleteMultiple).(bool)); err != nil {
+		return nil, err
+	}
 	if !c.isMetadataSetInProviderBlock {
-		if err := setKafkaCredentials(c.clusterApiKey, c.clusterApiSecret, d); err != nil {
-			return nil, err
+		if !isClusterApiKeyFromEnv(c) {
+			if err := setKafkaCredentials(c.clusterApiKey, c.clusterApiSecret, d); err != nil {
+				return nil, err
+			}
 		}
 		if err := d.Set(paramRestEndpoint, c.restEndpoint); err != nil {
 			return nil, err
@@ -404,7 +523,7 @@ func kafkaAclImport(ctx context.Context, d *schema.ResourceData, meta interface{
 	if err != nil {
 		return nil, fmt.Errorf("error importing Kafka Topic: %s", createDescriptiveError(err))
 	}
-	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, true)
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, "", true)
 	if err != nil {
 		return nil, fmt.Errorf("error importing Kafka Topic: %s", createDescriptiveError(err))
 	}
@@ -426,6 +545,18 @@ func kafkaAclImport(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	client := meta.(*Client)
+
+	// APIF-2043: ACLs created by other tooling might use a principal with an integer ID (User:6789)
+	// so it needs to be replaced with a principal with a resource ID (User:sa-abc123) to match the input validation
+	if principalWithIntegerIdRegex.MatchString(acl.Principal) {
+		principalWithResourceId, err := principalWithIntegerIdToPrincipalWithResourceId(client, acl.Principal)
+		if err != nil {
+			return nil, fmt.Errorf("error importing Kafka ACLs %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Replacing principal %q with %q when importing Kafka ACLs %q", acl.Principal, principalWithResourceId, d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
+		acl.Principal = principalWithResourceId
+	}
+
 	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaMetadataSet)
 
 	// Mark resource as new to avoid d.Set("") when getting 404
@@ -465,15 +596,48 @@ func deserializeAcl(serializedAcl string) (Acl, error) {
 		ResourceName: parts[1],
 		PatternType:  patternType,
 		Principal:    parts[3],
-		Host:         parts[4],
+		Host:         normalizeAclHost(parts[4]),
 		Operation:    operation,
 		Permission:   permission,
 	}, nil
 }
 
+// Some Kafka REST API endpoints return an empty host instead of "*"
+func normalizeAclHost(host string) string {
+	if host == "" {
+		return wildcardHost
+	}
+	return host
+}
+
+func aclHostDiffSuppressFunc(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	return normalizeAclHost(oldValue) == normalizeAclHost(newValue)
+}
+
+func kafkaAclCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client, _ := meta.(*Client)
+	if err := validateKafkaAclPrincipalForCluster(client, diff.Get(paramPrincipal).(string)); err != nil {
+		return err
+	}
+	return validateAclOperationForResourceType(diff.Get(paramResourceType).(string), diff.Get(paramOperation).(string))
+}
+
+func validateAclOperationForResourceType(resourceType, operation string) error {
+	acceptedOperationsForResourceType, ok := acceptedOperationsPerResourceType[resourceType]
+	// operation is empty when its value is not known until apply
+	if !ok || operation == "" || operation == "ANY" || operation == "UNKNOWN" {
+		return nil
+	}
+	if !stringInSlice(operation, acceptedOperationsForResourceType, false) {
+		return fmt.Errorf("%q operation is not supported for %q resource type, accepted operations are: %s",
+			operation, resourceType, strings.Join(acceptedOperationsForResourceType, ", "))
+	}
+	return nil
+}
+
 func kafkaAclUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramCredentials) {
-		return diag.Errorf("error updating Kafka ACLs %q: only %q block can be updated for Kafka ACLs", d.Id(), paramCredentials)
+	if d.HasChangesExcept(paramCredentials, paramForceDeleteMultiple) {
+		return nonUpdatableAttributeErrors(d, kafkaAclResource().Schema, fmt.Sprintf("error updating Kafka ACLs %q: only %q block and %q attribute can be updated for Kafka ACLs", d.Id(), paramCredentials, paramForceDeleteMultiple), paramCredentials, paramForceDeleteMultiple)
 	}
 	return kafkaAclRead(ctx, d, meta)
 }
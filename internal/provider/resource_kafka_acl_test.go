@@ -17,6 +17,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
 	"github.com/docker/go-connections/nat"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
@@ -278,3 +279,102 @@ func testAccCheckAclExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestValidateKafkaAclPrincipalForCluster(t *testing.T) {
+	cloudClient := &Client{}
+	selfManagedClient := &Client{kafkaRestBasePath: "/v3"}
+	for _, principal := range []string{"User:sa-abc123", "User:u-abc123", "User:pool-abc123", "User:*", ""} {
+		if err := validateKafkaAclPrincipalForCluster(cloudClient, principal); err != nil {
+			t.Fatalf("Expected %q to be accepted for a Confluent Cloud cluster, got %s", principal, err)
+		}
+	}
+	for _, principal := range []string{"User:alice", "Group:admins", "User:12345"} {
+		if err := validateKafkaAclPrincipalForCluster(cloudClient, principal); err == nil {
+			t.Fatalf("Expected %q to be rejected for a Confluent Cloud cluster", principal)
+		}
+		if err := validateKafkaAclPrincipalForCluster(selfManagedClient, principal); err != nil {
+			t.Fatalf("Expected %q to be accepted for a self-managed Kafka cluster, got %s", principal, err)
+		}
+	}
+}
+
+func TestNormalizeAclHost(t *testing.T) {
+	if actual := normalizeAclHost(""); actual != wildcardHost {
+		t.Fatalf("expected %q, got %q", wildcardHost, actual)
+	}
+	if actual := normalizeAclHost("10.0.0.1"); actual != "10.0.0.1" {
+		t.Fatalf("expected %q, got %q", "10.0.0.1", actual)
+	}
+	if !aclHostDiffSuppressFunc(paramHost, wildcardHost, "", nil) {
+		t.Fatalf("expected diff between %q and empty host to be suppressed", wildcardHost)
+	}
+}
+
+func TestStringToAclOperation(t *testing.T) {
+	for _, operation := range acceptedOperations {
+		actual, err := stringToAclOperation(operation)
+		if err != nil {
+			t.Fatalf("expected no error for %q, got %s", operation, err)
+		}
+		if string(actual) != operation {
+			t.Fatalf("expected %q, got %q", operation, actual)
+		}
+	}
+	if _, err := stringToAclOperation("FOO"); err == nil {
+		t.Fatalf("expected an error for an unknown ACL operation")
+	}
+}
+
+func TestValidateAclOperationForResourceType(t *testing.T) {
+	for _, validCombination := range [][2]string{{"TOPIC", "READ"}, {"GROUP", "READ"}, {"CLUSTER", "IDEMPOTENT_WRITE"}, {"ANY", "CLUSTER_ACTION"}, {"GROUP", "ANY"}, {"GROUP", ""}} {
+		if err := validateAclOperationForResourceType(validCombination[0], validCombination[1]); err != nil {
+			t.Fatalf("expected no error for %v, got %s", validCombination, err)
+		}
+	}
+	for _, invalidCombination := range [][2]string{{"GROUP", "CLUSTER_ACTION"}, {"TOPIC", "IDEMPOTENT_WRITE"}, {"TRANSACTIONAL_ID", "READ"}} {
+		if err := validateAclOperationForResourceType(invalidCombination[0], invalidCombination[1]); err == nil {
+			t.Fatalf("expected an error for %v", invalidCombination)
+		}
+	}
+}
+
+func TestKafkaAclUserResourceTypePlan(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		paramResourceType: "USER",
+		paramResourceName: "User:sa-abc123",
+		paramPatternType:  "LITERAL",
+		paramPrincipal:    "User:sa-xyz123",
+		paramHost:         "*",
+		paramOperation:    "CREATE_TOKENS",
+		paramPermission:   "ALLOW",
+	})
+	if diags := kafkaAclResource().Validate(config); diags.HasError() {
+		t.Fatalf("expected a USER Kafka ACL to be valid, got %v", diags)
+	}
+	if _, err := kafkaAclResource().Diff(context.Background(), nil, config, &Client{}); err != nil {
+		t.Fatalf("expected a USER Kafka ACL with CREATE_TOKENS operation to be planned, got %s", err)
+	}
+	if resourceType, err := stringToAclResourceType("USER"); err != nil || resourceType != "USER" {
+		t.Fatalf("expected USER to be converted to an ACL resource type, got %q, %v", resourceType, err)
+	}
+	if err := validateAclOperationForResourceType("TOPIC", "CREATE_TOKENS"); err == nil {
+		t.Fatalf("expected an error for TOPIC Kafka ACL with CREATE_TOKENS operation")
+	}
+}
+
+func TestAcceptedAclEnumsIncludeSdkEnums(t *testing.T) {
+	if !stringInSlice(string(kafkarestv3.ACLRESOURCETYPE_DELEGATION_TOKEN), acceptedResourceTypes, false) {
+		t.Fatalf("expected %q to be an accepted resource type", kafkarestv3.ACLRESOURCETYPE_DELEGATION_TOKEN)
+	}
+	if !stringInSlice(string(kafkarestv3.ACLPATTERNTYPE_PREFIXED), acceptedPatternTypes, false) {
+		t.Fatalf("expected %q to be an accepted pattern type", kafkarestv3.ACLPATTERNTYPE_PREFIXED)
+	}
+	for _, operation := range []string{string(kafkarestv3.ACLOPERATION_IDEMPOTENT_WRITE), "CREATE_TOKENS", "DESCRIBE_TOKENS"} {
+		if !stringInSlice(operation, acceptedOperations, false) {
+			t.Fatalf("expected %q to be an accepted operation", operation)
+		}
+	}
+	if !stringInSlice(string(kafkarestv3.ACLPERMISSION_ALLOW), acceptedPermissions, false) {
+		t.Fatalf("expected %q to be an accepted permission", kafkarestv3.ACLPERMISSION_ALLOW)
+	}
+}
@@ -15,6 +15,7 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -23,25 +24,30 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"io/ioutil"
 	"net/http"
 	"regexp"
 	"strings"
 )
 
 const (
-	kafkaClusterTypeBasic     = "Basic"
-	kafkaClusterTypeStandard  = "Standard"
-	kafkaClusterTypeDedicated = "Dedicated"
-	paramBasicCluster         = "basic"
-	paramStandardCluster      = "standard"
-	paramDedicatedCluster     = "dedicated"
-	paramAvailability         = "availability"
-	paramBootStrapEndpoint    = "bootstrap_endpoint"
-	paramRestEndpoint         = "rest_endpoint"
-	paramHttpEndpoint         = "http_endpoint"
-	paramCku                  = "cku"
-	paramEncryptionKey        = "encryption_key"
-	paramRbacCrn              = "rbac_crn"
+	kafkaClusterTypeBasic      = "Basic"
+	kafkaClusterTypeStandard   = "Standard"
+	kafkaClusterTypeDedicated  = "Dedicated"
+	kafkaClusterTypeEnterprise = "Enterprise"
+	kafkaClusterTypeFreight    = "Freight"
+	paramBasicCluster          = "basic"
+	paramStandardCluster       = "standard"
+	paramDedicatedCluster      = "dedicated"
+	paramEnterpriseCluster     = "enterprise"
+	paramFreightCluster        = "freight"
+	paramAvailability          = "availability"
+	paramBootStrapEndpoint     = "bootstrap_endpoint"
+	paramRestEndpoint          = "rest_endpoint"
+	paramHttpEndpoint          = "http_endpoint"
+	paramCku                   = "cku"
+	paramEncryptionKey         = "encryption_key"
+	paramRbacCrn               = "rbac_crn"
 
 	stateInProgress = "IN_PROGRESS"
 	stateDone       = "DONE"
@@ -57,11 +63,24 @@ const (
 
 	singleZone = "SINGLE_ZONE"
 	multiZone  = "MULTI_ZONE"
+	// Availability values of Enterprise and Freight clusters
+	lowAvailability  = "LOW"
+	highAvailability = "HIGH"
 )
 
 var acceptedAvailabilityZones = []string{singleZone, multiZone}
+
+// Availability values accepted by each Kafka cluster type, checked on plan since they depend on the cluster type block
+var acceptedAvailabilityZonesPerClusterType = map[string][]string{
+	kafkaClusterTypeBasic:      acceptedAvailabilityZones,
+	kafkaClusterTypeStandard:   acceptedAvailabilityZones,
+	kafkaClusterTypeDedicated:  acceptedAvailabilityZones,
+	kafkaClusterTypeEnterprise: {lowAvailability, highAvailability},
+	// Freight clusters always span multiple zones
+	kafkaClusterTypeFreight: {highAvailability},
+}
 var acceptedCloudProviders = []string{"AWS", "AZURE", "GCP"}
-var acceptedClusterTypes = []string{paramBasicCluster, paramStandardCluster, paramDedicatedCluster}
+var acceptedClusterTypes = []string{paramBasicCluster, paramStandardCluster, paramDedicatedCluster, paramEnterpriseCluster, paramFreightCluster}
 var paramDedicatedCku = fmt.Sprintf("%s.0.%s", paramDedicatedCluster, paramCku)
 var paramDedicatedEncryptionKey = fmt.Sprintf("%s.0.%s", paramDedicatedCluster, paramEncryptionKey)
 
@@ -74,6 +93,7 @@ func kafkaResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: kafkaImport,
 		},
+		CustomizeDiff: kafkaClusterCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			paramDisplayName: {
 				Type:         schema.TypeString,
@@ -96,7 +116,7 @@ func kafkaResource() *schema.Resource {
 				Required:     true,
 				ForceNew:     true,
 				Description:  "The availability zone configuration of the Kafka cluster.",
-				ValidateFunc: validation.StringInSlice(acceptedAvailabilityZones, false),
+				ValidateFunc: validation.StringInSlice([]string{singleZone, multiZone, lowAvailability, highAvailability}, false),
 			},
 			paramCloud: {
 				Type:         schema.TypeString,
@@ -111,10 +131,12 @@ func kafkaResource() *schema.Resource {
 				ForceNew:    true,
 				Description: "The cloud service provider region where the Kafka cluster is running.",
 			},
-			paramNetwork:          optionalNetworkSchema(),
-			paramBasicCluster:     basicClusterSchema(),
-			paramStandardCluster:  standardClusterSchema(),
-			paramDedicatedCluster: dedicatedClusterSchema(),
+			paramNetwork:           optionalNetworkSchema(),
+			paramBasicCluster:      basicClusterSchema(),
+			paramStandardCluster:   standardClusterSchema(),
+			paramDedicatedCluster:  dedicatedClusterSchema(),
+			paramEnterpriseCluster: enterpriseClusterSchema(),
+			paramFreightCluster:    freightClusterSchema(),
 			paramBootStrapEndpoint: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -131,13 +153,15 @@ func kafkaResource() *schema.Resource {
 				Description: "The Confluent Resource Name of the Kafka cluster suitable for " +
 					"confluent_role_binding's crn_pattern.",
 			},
-			paramEnvironment: environmentSchema(),
+			paramEnvironment: environmentSchemaWithDefault(),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			// https://docs.confluent.io/cloud/current/clusters/cluster-types.html#provisioning-time
 			Create: schema.DefaultTimeout(getTimeoutFor(kafkaClusterTypeDedicated)),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
 			// https://docs.confluent.io/cloud/current/clusters/cluster-types.html#resizing-time
 			Update: schema.DefaultTimeout(getTimeoutFor(kafkaClusterTypeDedicated)),
+			Delete: schema.DefaultTimeout(defaultResourceTimeout),
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -185,10 +209,11 @@ func kafkaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	}
 
 	// Allow only Basic -> Standard upgrade
-	isBasicStandardUpdate := d.HasChange(paramBasicCluster) && d.HasChange(paramStandardCluster) && !d.HasChange(paramDedicatedCluster) && clusterType == kafkaClusterTypeStandard
+	isBasicStandardUpdate := d.HasChange(paramBasicCluster) && d.HasChange(paramStandardCluster) && !d.HasChange(paramDedicatedCluster) && !d.HasChange(paramEnterpriseCluster) && clusterType == kafkaClusterTypeStandard
 	// Watch out for forbidden updates / downgrades: e.g., Standard -> Basic, Basic -> Dedicated etc.
-	isForbiddenStandardBasicDowngrade := d.HasChange(paramBasicCluster) && d.HasChange(paramStandardCluster) && !d.HasChange(paramDedicatedCluster) && clusterType == kafkaClusterTypeBasic
-	isForbiddenDedicatedUpdate := d.HasChange(paramDedicatedCluster) && (d.HasChange(paramBasicCluster) || d.HasChange(paramStandardCluster))
+	isForbiddenStandardBasicDowngrade := d.HasChange(paramBasicCluster) && d.HasChange(paramStandardCluster) && !d.HasChange(paramDedicatedCluster) && !d.HasChange(paramEnterpriseCluster) && clusterType == kafkaClusterTypeBasic
+	isForbiddenDedicatedUpdate := d.HasChange(paramDedicatedCluster) && (d.HasChange(paramBasicCluster) || d.HasChange(paramStandardCluster) || d.HasChange(paramEnterpriseCluster))
+	isForbiddenEnterpriseUpdate := d.HasChange(paramEnterpriseCluster) || d.HasChange(paramFreightCluster)
 
 	if isBasicStandardUpdate {
 		updateClusterRequest := cmk.NewCmkV2ClusterUpdate()
@@ -214,7 +239,7 @@ func kafkaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 			return diag.Errorf("error updating Kafka Cluster %q: error marshaling %#v to json: %s", d.Id(), updatedCluster, createDescriptiveError(err))
 		}
 		tflog.Debug(ctx, fmt.Sprintf("Updated Kafka Cluster %q: %s", d.Id(), updatedClusterJson), map[string]interface{}{kafkaClusterLoggingKey: d.Id()})
-	} else if isForbiddenStandardBasicDowngrade || isForbiddenDedicatedUpdate {
+	} else if isForbiddenStandardBasicDowngrade || isForbiddenDedicatedUpdate || isForbiddenEnterpriseUpdate {
 		return diag.Errorf("error updating Kafka Cluster %q: clusters can only be upgraded from 'Basic' to 'Standard'", d.Id())
 	}
 
@@ -225,6 +250,10 @@ func kafkaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		if err != nil {
 			return diag.FromErr(createDescriptiveError(err))
 		}
+		oldCku, _ := d.GetChange(paramDedicatedCku)
+		if err := ckuUpdateCheck(int32(oldCku.(int)), cku); err != nil {
+			return diag.Errorf("error updating Kafka Cluster %q: %s", d.Id(), createDescriptiveError(err))
+		}
 
 		updateClusterRequest := cmk.NewCmkV2ClusterUpdate()
 		updateSpec := cmk.NewCmkV2ClusterSpecUpdate()
@@ -243,7 +272,7 @@ func kafkaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 			return diag.Errorf("error updating Kafka Cluster %q: %s", d.Id(), createDescriptiveError(err))
 		}
 
-		if err := waitForKafkaClusterCkuUpdateToComplete(c.cmkApiContext(ctx), c, environmentId, d.Id(), cku); err != nil {
+		if err := waitForKafkaClusterCkuUpdateToComplete(c.cmkApiContext(ctx), c, environmentId, d.Id(), cku, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return diag.Errorf("error waiting for Kafka Cluster %q to perform CKU update: %s", d.Id(), createDescriptiveError(err))
 		}
 		updatedClusterJson, err := json.Marshal(updatedCluster)
@@ -297,6 +326,19 @@ func kafkaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		}
 
 		spec.SetConfig(cmk.CmkV2DedicatedAsCmkV2ClusterSpecConfigOneOf(config))
+	} else if clusterType == kafkaClusterTypeEnterprise {
+		if err := enterpriseClusterCheck(networkId); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+		// The SDK doesn't model Enterprise clusters yet, and the Basic model only consists of the "kind" field,
+		// so it's serialized into the same {"kind": "Enterprise"} config the API expects
+		spec.SetConfig(cmk.CmkV2BasicAsCmkV2ClusterSpecConfigOneOf(cmk.NewCmkV2Basic(kafkaClusterTypeEnterprise)))
+	} else if clusterType == kafkaClusterTypeFreight {
+		if err := freightClusterCheck(cloud); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+		// Same as for Enterprise clusters
+		spec.SetConfig(cmk.CmkV2BasicAsCmkV2ClusterSpecConfigOneOf(cmk.NewCmkV2Basic(kafkaClusterTypeFreight)))
 	} else {
 		return diag.Errorf("error creating Kafka Cluster: unknown Kafka Cluster type was provided: %q", clusterType)
 	}
@@ -317,7 +359,12 @@ func kafkaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(createdKafkaCluster.GetId())
 
-	if err := waitForKafkaClusterToProvision(c.cmkApiContext(ctx), c, environmentId, d.Id(), clusterType); err != nil {
+	// The default create timeout is the one of Dedicated clusters, Basic and Standard clusters provision much faster
+	createTimeout := d.Timeout(schema.TimeoutCreate)
+	if !isTimeoutSetInConfig(d, schema.TimeoutCreate) {
+		createTimeout = getTimeoutFor(clusterType)
+	}
+	if err := waitForKafkaClusterToProvision(c.cmkApiContext(ctx), c, environmentId, d.Id(), createTimeout); err != nil {
 		return diag.Errorf("error waiting for Kafka Cluster %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -330,10 +377,17 @@ func kafkaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 	return kafkaRead(ctx, d, meta)
 }
 
-func extractClusterType(d *schema.ResourceData) string {
+// Implemented by both schema.ResourceData and schema.ResourceDiff
+type resourceAttributeGetter interface {
+	Get(key string) interface{}
+}
+
+func extractClusterType(d resourceAttributeGetter) string {
 	basicConfigBlock := d.Get(paramBasicCluster).([]interface{})
 	standardConfigBlock := d.Get(paramStandardCluster).([]interface{})
 	dedicatedConfigBlock := d.Get(paramDedicatedCluster).([]interface{})
+	enterpriseConfigBlock := d.Get(paramEnterpriseCluster).([]interface{})
+	freightConfigBlock := d.Get(paramFreightCluster).([]interface{})
 
 	if len(basicConfigBlock) == 1 {
 		return kafkaClusterTypeBasic
@@ -341,6 +395,10 @@ func extractClusterType(d *schema.ResourceData) string {
 		return kafkaClusterTypeStandard
 	} else if len(dedicatedConfigBlock) == 1 {
 		return kafkaClusterTypeDedicated
+	} else if len(enterpriseConfigBlock) == 1 {
+		return kafkaClusterTypeEnterprise
+	} else if len(freightConfigBlock) == 1 {
+		return kafkaClusterTypeFreight
 	}
 	return ""
 }
@@ -436,7 +494,7 @@ func readKafkaClusterAndSetAttributes(ctx context.Context, d *schema.ResourceDat
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Fetched Kafka Cluster %q: %s", d.Id(), clusterJson), map[string]interface{}{kafkaClusterLoggingKey: d.Id()})
 
-	if _, err := setKafkaClusterAttributes(d, cluster); err != nil {
+	if _, err := setKafkaClusterAttributes(d, cluster, extractKafkaClusterConfigKinds(resp)); err != nil {
 		return nil, createDescriptiveError(err)
 	}
 
@@ -494,6 +552,63 @@ func dedicatedClusterSchema() *schema.Schema {
 	}
 }
 
+func enterpriseClusterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 0,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{},
+		},
+		ExactlyOneOf: acceptedClusterTypes,
+	}
+}
+
+// Enterprise clusters are only reachable through PrivateLink Attachments of their environment
+func enterpriseClusterCheck(networkId string) error {
+	if networkId != "" {
+		return fmt.Errorf("enterprise clusters don't support the %q block since they're connected to through PrivateLink Attachments of their environment", paramNetwork)
+	}
+	return nil
+}
+
+func freightClusterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 0,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{},
+		},
+		ExactlyOneOf: acceptedClusterTypes,
+	}
+}
+
+// Freight clusters are only available on AWS
+func freightClusterCheck(cloud string) error {
+	if cloud != "AWS" {
+		return fmt.Errorf("freight clusters are only available on AWS")
+	}
+	return nil
+}
+
+func kafkaClusterCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	return availabilityCheck(extractClusterType(diff), diff.Get(paramAvailability).(string))
+}
+
+func availabilityCheck(clusterType, availability string) error {
+	acceptedAvailabilityZonesForClusterType, ok := acceptedAvailabilityZonesPerClusterType[clusterType]
+	// availability is empty when its value is not known until apply
+	if !ok || availability == "" {
+		return nil
+	}
+	if !stringInSlice(availability, acceptedAvailabilityZonesForClusterType, false) {
+		return fmt.Errorf("%q availability is not supported for %s clusters, accepted values are: %s",
+			availability, strings.ToLower(clusterType), strings.Join(acceptedAvailabilityZonesForClusterType, ", "))
+	}
+	return nil
+}
+
 func ckuCheck(cku int32, availability string) error {
 	if cku < 1 && availability == singleZone {
 		return fmt.Errorf("single-zone dedicated clusters must have at least 1 CKU")
@@ -503,7 +618,15 @@ func ckuCheck(cku int32, availability string) error {
 	return nil
 }
 
-func setKafkaClusterAttributes(d *schema.ResourceData, cluster cmk.CmkV2Cluster) (*schema.ResourceData, error) {
+// Dedicated clusters can be expanded by any number of CKUs but only shrunk by one CKU at a time
+func ckuUpdateCheck(oldCku, newCku int32) error {
+	if newCku < oldCku-1 {
+		return fmt.Errorf("dedicated clusters can only be shrunk by 1 CKU at a time: update %q from %d to %d first", paramCku, oldCku, oldCku-1)
+	}
+	return nil
+}
+
+func setKafkaClusterAttributes(d *schema.ResourceData, cluster cmk.CmkV2Cluster, configKinds kafkaClusterConfigKinds) (*schema.ResourceData, error) {
 	if err := d.Set(paramApiVersion, cluster.GetApiVersion()); err != nil {
 		return nil, err
 	}
@@ -523,7 +646,7 @@ func setKafkaClusterAttributes(d *schema.ResourceData, cluster cmk.CmkV2Cluster)
 		return nil, err
 	}
 
-	// Reset all 3 cluster types since only one of these 3 should be set
+	// Reset all 5 cluster types since only one of these 5 should be set
 	if err := d.Set(paramBasicCluster, []interface{}{}); err != nil {
 		return nil, err
 	}
@@ -533,6 +656,12 @@ func setKafkaClusterAttributes(d *schema.ResourceData, cluster cmk.CmkV2Cluster)
 	if err := d.Set(paramDedicatedCluster, []interface{}{}); err != nil {
 		return nil, err
 	}
+	if err := d.Set(paramEnterpriseCluster, []interface{}{}); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramFreightCluster, []interface{}{}); err != nil {
+		return nil, err
+	}
 
 	// Set a specific cluster type
 	if cluster.Spec.Config.CmkV2Basic != nil {
@@ -550,6 +679,14 @@ func setKafkaClusterAttributes(d *schema.ResourceData, cluster cmk.CmkV2Cluster)
 		}}); err != nil {
 			return nil, err
 		}
+	} else if configKinds[cluster.GetId()] == kafkaClusterTypeEnterprise {
+		if err := d.Set(paramEnterpriseCluster, []interface{}{make(map[string]string)}); err != nil {
+			return nil, err
+		}
+	} else if configKinds[cluster.GetId()] == kafkaClusterTypeFreight {
+		if err := d.Set(paramFreightCluster, []interface{}{make(map[string]string)}); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := d.Set(paramBootStrapEndpoint, cluster.Spec.GetKafkaBootstrapEndpoint()); err != nil {
@@ -575,6 +712,45 @@ func setKafkaClusterAttributes(d *schema.ResourceData, cluster cmk.CmkV2Cluster)
 	return d, nil
 }
 
+// Kinds of cluster configs by cluster ID, for example, "Enterprise". The SDK doesn't model Enterprise and Freight
+// clusters yet, so their configs are decoded into an empty one-of and their kinds are read from the response body instead.
+type kafkaClusterConfigKinds map[string]string
+
+type kafkaClusterConfigKind struct {
+	Id   string `json:"id"`
+	Spec struct {
+		Config struct {
+			Kind string `json:"kind"`
+		} `json:"config"`
+	} `json:"spec"`
+}
+
+// Accepts the responses of both reading and listing Kafka clusters since the SDK resets their bodies after decoding them
+func extractKafkaClusterConfigKinds(resp *http.Response) kafkaClusterConfigKinds {
+	configKinds := kafkaClusterConfigKinds{}
+	if resp == nil || resp.Body == nil {
+		return configKinds
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return configKinds
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	var clusters struct {
+		kafkaClusterConfigKind
+		Data []kafkaClusterConfigKind `json:"data"`
+	}
+	if err := json.Unmarshal(body, &clusters); err != nil {
+		return configKinds
+	}
+	for _, cluster := range append(clusters.Data, clusters.kafkaClusterConfigKind) {
+		if cluster.Id != "" {
+			configKinds[cluster.Id] = cluster.Spec.Config.Kind
+		}
+	}
+	return configKinds
+}
+
 func optionalNetworkSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeList,
@@ -0,0 +1,326 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/antihax/optional"
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+)
+
+const (
+	kafkaClusterConfigLoggingKey = "kafka_cluster_config_id"
+
+	alterConfigOperationDelete = "DELETE"
+	clusterConfigDocsUrl       = "https://docs.confluent.io/cloud/current/clusters/broker-config.html#change-cluster-settings-for-dedicated-clusters"
+)
+
+// https://docs.confluent.io/cloud/current/clusters/broker-config.html#change-cluster-settings-for-dedicated-clusters
+var editableClusterSettings = []string{"auto.create.topics.enable", "log.cleaner.max.compaction.lag.ms", "log.retention.ms",
+	"num.partitions", "ssl.cipher.suites"}
+
+func kafkaClusterConfigResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: kafkaClusterConfigCreate,
+		ReadContext:   kafkaClusterConfigRead,
+		UpdateContext: kafkaClusterConfigUpdate,
+		DeleteContext: kafkaClusterConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: kafkaClusterConfigImport,
+		},
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: optionalKafkaClusterBlockSchema(),
+			paramConfigs: {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required:         true,
+				Description:      "The custom cluster settings to set (e.g., `\"auto.create.topics.enable\" = \"false\"`).",
+				ValidateDiagFunc: validateEditableClusterSettings,
+			},
+			paramRestEndpoint: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The REST endpoint of the Kafka cluster (e.g., `https://pkc-00000.us-central1.gcp.confluent.cloud:443`).",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
+			},
+			paramCredentials: credentialsSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultResourceTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Update: schema.DefaultTimeout(defaultResourceTimeout),
+			Delete: schema.DefaultTimeout(defaultResourceTimeout),
+		},
+	}
+}
+
+func validateEditableClusterSettings(i interface{}, path cty.Path) diag.Diagnostics {
+	configs, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	var diags diag.Diagnostics
+	for name := range configs {
+		if !stringInSlice(name, editableClusterSettings, false) {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("%q cluster setting is read-only and cannot be updated", name),
+				Detail:        fmt.Sprintf("Only %v cluster settings can be updated. Read %s for more details.", editableClusterSettings, clusterConfigDocsUrl),
+				AttributePath: path.IndexString(name),
+			})
+		}
+	}
+	return diags
+}
+
+func createKafkaRestClientForClusterConfig(client *Client, d *schema.ResourceData, clusterId string, isImportOperation bool) (*KafkaRestClient, error) {
+	restEndpoint, err := extractRestEndpoint(client, d, isImportOperation)
+	if err != nil {
+		return nil, err
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(client, d, clusterId, isImportOperation)
+	if err != nil {
+		return nil, err
+	}
+	return client.kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, client.isKafkaMetadataSet), nil
+}
+
+func kafkaClusterConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Cluster Config: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient, err := createKafkaRestClientForClusterConfig(meta.(*Client), d, clusterId, false)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Cluster Config: %s", createDescriptiveError(err))
+	}
+
+	updateConfigRequest := kafkarestv3.AlterConfigBatchRequestData{
+		Data: clusterSettingsUpdateBatch(nil, convertToStringStringMap(d.Get(paramConfigs).(map[string]interface{}))),
+	}
+	updateConfigRequestJson, err := json.Marshal(updateConfigRequest)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Cluster Config: error marshaling %#v to json: %s", updateConfigRequest, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Creating new Kafka Cluster Config: %s", updateConfigRequestJson))
+
+	if _, err := executeKafkaClusterConfigUpdate(ctx, kafkaRestClient, updateConfigRequest); err != nil {
+		return diag.Errorf("error creating Kafka Cluster Config: %s", createDescriptiveError(err))
+	}
+	d.SetId(clusterId)
+
+	// Give some time to Kafka REST API to apply an update of cluster settings
+	time.Sleep(kafkaRestAPIWaitAfterCreate)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Kafka Cluster Config %q", d.Id()), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
+
+	return kafkaClusterConfigRead(ctx, d, meta)
+}
+
+func kafkaClusterConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Cluster Config %q", d.Id()), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
+
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Cluster Config: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient, err := createKafkaRestClientForClusterConfig(meta.(*Client), d, clusterId, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Cluster Config: %s", createDescriptiveError(err))
+	}
+
+	if _, err := readClusterConfigAndSetAttributes(ctx, d, kafkaRestClient); err != nil {
+		return diag.Errorf("error reading Kafka Cluster Config: %s", createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Kafka Cluster Config %q", d.Id()), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
+
+	return nil
+}
+
+func kafkaClusterConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChangesExcept(paramCredentials, paramConfigs) {
+		return nonUpdatableAttributeErrors(d, kafkaClusterConfigResource().Schema, fmt.Sprintf("error updating Kafka Cluster Config %q: only %q, %q blocks can be updated for Kafka Cluster Config", d.Id(), paramCredentials, paramConfigs), paramCredentials, paramConfigs)
+	}
+	if d.HasChange(paramConfigs) {
+		clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Cluster Config %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		kafkaRestClient, err := createKafkaRestClientForClusterConfig(meta.(*Client), d, clusterId, false)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Cluster Config %q: %s", d.Id(), createDescriptiveError(err))
+		}
+
+		// Cluster settings that were removed from the 'config' block are reset to their default values
+		oldConfigs, newConfigs := d.GetChange(paramConfigs)
+		updateConfigRequest := kafkarestv3.AlterConfigBatchRequestData{
+			Data: clusterSettingsUpdateBatch(convertToStringStringMap(oldConfigs.(map[string]interface{})), convertToStringStringMap(newConfigs.(map[string]interface{}))),
+		}
+		updateConfigRequestJson, err := json.Marshal(updateConfigRequest)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Cluster Config %q: error marshaling %#v to json: %s", d.Id(), updateConfigRequest, createDescriptiveError(err))
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Updating Kafka Cluster Config %q: %s", d.Id(), updateConfigRequestJson), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
+
+		if _, err := executeKafkaClusterConfigUpdate(ctx, kafkaRestClient, updateConfigRequest); err != nil {
+			return diag.Errorf("error updating Kafka Cluster Config %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		// Give some time to Kafka REST API to apply an update of cluster settings
+		time.Sleep(kafkaRestAPIWaitAfterCreate)
+
+		tflog.Debug(ctx, fmt.Sprintf("Finished updating Kafka Cluster Config %q", d.Id()), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
+	}
+	return kafkaClusterConfigRead(ctx, d, meta)
+}
+
+func kafkaClusterConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Kafka Cluster Config %q", d.Id()), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
+
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka Cluster Config %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	kafkaRestClient, err := createKafkaRestClientForClusterConfig(meta.(*Client), d, clusterId, false)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka Cluster Config %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	// Deleting the resource resets all managed cluster settings to their default values
+	updateConfigRequest := kafkarestv3.AlterConfigBatchRequestData{
+		Data: clusterSettingsUpdateBatch(convertToStringStringMap(d.Get(paramConfigs).(map[string]interface{})), nil),
+	}
+	if _, err := executeKafkaClusterConfigUpdate(ctx, kafkaRestClient, updateConfigRequest); err != nil {
+		return diag.Errorf("error deleting Kafka Cluster Config %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Kafka Cluster Config %q", d.Id()), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
+
+	return nil
+}
+
+func kafkaClusterConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tflog.Debug(ctx, fmt.Sprintf("Importing Kafka Cluster Config %q", d.Id()), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
+
+	clusterId := d.Id()
+	kafkaRestClient, err := createKafkaRestClientForClusterConfig(meta.(*Client), d, clusterId, true)
+	if err != nil {
+		return nil, fmt.Errorf("error importing Kafka Cluster Config: %s", createDescriptiveError(err))
+	}
+
+	// Mark resource as new to avoid d.Set("") when getting 404
+	d.MarkNewResource()
+	if _, err := readClusterConfigAndSetAttributes(ctx, d, kafkaRestClient); err != nil {
+		return nil, fmt.Errorf("error importing Kafka Cluster Config %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished importing Kafka Cluster Config %q", d.Id()), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
+	return []*schema.ResourceData{d}, nil
+}
+
+func readClusterConfigAndSetAttributes(ctx context.Context, d *schema.ResourceData, c *KafkaRestClient) ([]*schema.ResourceData, error) {
+	clusterConfigList, resp, err := c.apiClient.ConfigsV3Api.ListKafkaV3ClusterConfigs(c.apiContext(ctx), c.clusterId)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error reading Kafka Cluster Config %q: %s", d.Id(), createDescriptiveError(err)), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
+
+		isResourceNotFound := ResponseHasExpectedStatusCode(resp, http.StatusNotFound)
+		if isResourceNotFound && !d.IsNewResource() {
+			tflog.Warn(ctx, fmt.Sprintf("Removing Kafka Cluster Config %q in TF state because Kafka Cluster could not be found on the server", d.Id()), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
+			d.SetId("")
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	configs := make(map[string]string)
+	for _, remoteConfig := range clusterConfigList.Data {
+		// Extract cluster settings that were changed vs set by default
+		if remoteConfig.Source == kafkarestv3.CONFIGSOURCE_DYNAMIC_DEFAULT_BROKER_CONFIG && remoteConfig.Value != nil {
+			configs[remoteConfig.Name] = *remoteConfig.Value
+		}
+	}
+	configsJson, err := json.Marshal(configs)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Kafka Cluster Config %q: error marshaling %#v to json: %s", d.Id(), configs, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Kafka Cluster Config %q: %s", d.Id(), configsJson), map[string]interface{}{kafkaClusterConfigLoggingKey: d.Id()})
+
+	if err := setStringAttributeInListBlockOfSizeOne(paramKafkaCluster, paramId, c.clusterId, d); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramConfigs, configs); err != nil {
+		return nil, err
+	}
+	if !c.isMetadataSetInProviderBlock {
+		if !isClusterApiKeyFromEnv(c) {
+			if err := setKafkaCredentials(c.clusterApiKey, c.clusterApiSecret, d); err != nil {
+				return nil, err
+			}
+		}
+		if err := d.Set(paramRestEndpoint, c.restEndpoint); err != nil {
+			return nil, err
+		}
+	}
+	d.SetId(c.clusterId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Returns the batch that sets cluster settings that were added or changed and resets cluster settings that were removed
+func clusterSettingsUpdateBatch(oldConfigs, newConfigs map[string]string) []kafkarestv3.AlterConfigBatchRequestDataData {
+	batch := make([]kafkarestv3.AlterConfigBatchRequestDataData, 0)
+	for name, newValue := range newConfigs {
+		if oldValue, ok := oldConfigs[name]; !ok || oldValue != newValue {
+			batch = append(batch, kafkarestv3.AlterConfigBatchRequestDataData{
+				Name:  name,
+				Value: ptr(newValue),
+			})
+		}
+	}
+	for name := range oldConfigs {
+		if _, ok := newConfigs[name]; !ok {
+			batch = append(batch, kafkarestv3.AlterConfigBatchRequestDataData{
+				Name:      name,
+				Operation: ptr(alterConfigOperationDelete),
+			})
+		}
+	}
+	// Keeps requests stable for logging and testing
+	sort.Slice(batch, func(i, j int) bool {
+		return batch[i].Name < batch[j].Name
+	})
+	return batch
+}
+
+func executeKafkaClusterConfigUpdate(ctx context.Context, c *KafkaRestClient, requestData kafkarestv3.AlterConfigBatchRequestData) (*http.Response, error) {
+	opts := &kafkarestv3.UpdateKafkaV3ClusterConfigsOpts{
+		AlterConfigBatchRequestData: optional.NewInterface(requestData),
+	}
+	return c.apiClient.ConfigsV3Api.UpdateKafkaV3ClusterConfigs(c.apiContext(ctx), c.clusterId, opts)
+}
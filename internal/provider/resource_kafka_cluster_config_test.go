@@ -0,0 +1,41 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestClusterSettingsUpdateBatch(t *testing.T) {
+	oldConfigs := map[string]string{"auto.create.topics.enable": "false", "num.partitions": "6", "log.retention.ms": "604800000"}
+	newConfigs := map[string]string{"auto.create.topics.enable": "true", "num.partitions": "6", "ssl.cipher.suites": "TLS_AES_256_GCM_SHA384"}
+	batch := clusterSettingsUpdateBatch(oldConfigs, newConfigs)
+	batchJson, _ := json.Marshal(batch)
+	expectedBatchJson := `[{"name":"auto.create.topics.enable","value":"true"},{"name":"log.retention.ms","operation":"DELETE"},{"name":"ssl.cipher.suites","value":"TLS_AES_256_GCM_SHA384"}]`
+	if string(batchJson) != expectedBatchJson {
+		t.Fatalf("Expected %s, got %s", expectedBatchJson, batchJson)
+	}
+	if batch := clusterSettingsUpdateBatch(oldConfigs, oldConfigs); len(batch) != 0 {
+		t.Fatalf("Expected an empty batch for unchanged cluster settings, got %v", batch)
+	}
+
+	if diags := validateEditableClusterSettings(map[string]interface{}{"num.partitions": "6"}, nil); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if diags := validateEditableClusterSettings(map[string]interface{}{"min.insync.replicas": "2"}, nil); !diags.HasError() {
+		t.Fatalf("Expected an error for a read-only cluster setting")
+	}
+}
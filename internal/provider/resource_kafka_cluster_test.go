@@ -16,7 +16,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	cmk "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
 	"github.com/docker/go-connections/nat"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
@@ -24,6 +26,7 @@ import (
 	"github.com/walkerus/go-wiremock"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -286,3 +289,86 @@ func testAccCheckClusterExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestEnterpriseAndFreightKafkaClusters(t *testing.T) {
+	config, err := json.Marshal(cmk.CmkV2BasicAsCmkV2ClusterSpecConfigOneOf(cmk.NewCmkV2Basic(kafkaClusterTypeEnterprise)))
+	if err != nil || string(config) != `{"kind":"Enterprise"}` {
+		t.Fatalf("Unexpected Enterprise cluster config %s: %v", config, err)
+	}
+
+	body := `{"id":"lkc-abc123","spec":{"config":{"kind":"Enterprise"}}}`
+	resp := &http.Response{Body: ioutil.NopCloser(strings.NewReader(body))}
+	if kinds := extractKafkaClusterConfigKinds(resp); kinds["lkc-abc123"] != kafkaClusterTypeEnterprise {
+		t.Fatalf("Expected the Enterprise kind to be read from the response body, got %v", kinds)
+	}
+	if rest, _ := ioutil.ReadAll(resp.Body); string(rest) != body {
+		t.Fatalf("Expected the response body to be left readable, got %q", rest)
+	}
+	listBody := `{"data":[{"id":"lkc-abc123","spec":{"config":{"kind":"Freight"}}},{"id":"lkc-def456","spec":{"config":{"kind":"Basic"}}}]}`
+	kinds := extractKafkaClusterConfigKinds(&http.Response{Body: ioutil.NopCloser(strings.NewReader(listBody))})
+	if kinds["lkc-abc123"] != kafkaClusterTypeFreight || kinds["lkc-def456"] != kafkaClusterTypeBasic {
+		t.Fatalf("Unexpected kinds of listed Kafka clusters: %v", kinds)
+	}
+
+	if err := enterpriseClusterCheck(""); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := enterpriseClusterCheck("n-abc123"); err == nil {
+		t.Fatalf("Expected an error for an Enterprise cluster with a network")
+	}
+	if err := freightClusterCheck("AWS"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if err := freightClusterCheck("GCP"); err == nil {
+		t.Fatalf("Expected an error for a Freight cluster on GCP")
+	}
+}
+
+func TestAvailabilityCheck(t *testing.T) {
+	for clusterType, availabilities := range map[string][]string{
+		kafkaClusterTypeBasic:      {singleZone, multiZone},
+		kafkaClusterTypeDedicated:  {singleZone, multiZone},
+		kafkaClusterTypeEnterprise: {lowAvailability, highAvailability},
+		kafkaClusterTypeFreight:    {highAvailability},
+	} {
+		for _, availability := range availabilities {
+			if err := availabilityCheck(clusterType, availability); err != nil {
+				t.Fatalf("Unexpected error for a %s cluster with %q availability: %s", clusterType, availability, err)
+			}
+		}
+	}
+	for clusterType, availability := range map[string]string{
+		kafkaClusterTypeBasic:      lowAvailability,
+		kafkaClusterTypeStandard:   highAvailability,
+		kafkaClusterTypeEnterprise: singleZone,
+		kafkaClusterTypeFreight:    lowAvailability,
+	} {
+		if err := availabilityCheck(clusterType, availability); err == nil {
+			t.Fatalf("Expected an error for a %s cluster with %q availability", clusterType, availability)
+		}
+	}
+
+	// Validated on plan
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		paramDisplayName:     "orders",
+		paramAvailability:    lowAvailability,
+		paramCloud:           "AWS",
+		paramRegion:          "us-east-1",
+		paramEnvironment:     []interface{}{map[string]interface{}{paramId: "env-abc123"}},
+		paramStandardCluster: []interface{}{map[string]interface{}{}},
+	})
+	if _, err := kafkaResource().Diff(context.Background(), nil, config, &Client{}); err == nil || !strings.Contains(err.Error(), "not supported for standard clusters") {
+		t.Fatalf("Expected an error when planning a Standard cluster with %q availability, got %v", lowAvailability, err)
+	}
+}
+
+func TestCkuUpdateCheck(t *testing.T) {
+	for _, update := range [][]int32{{2, 5}, {3, 2}, {2, 2}} {
+		if err := ckuUpdateCheck(update[0], update[1]); err != nil {
+			t.Fatalf("Unexpected error for the CKU update from %d to %d: %s", update[0], update[1], err)
+		}
+	}
+	if err := ckuUpdateCheck(4, 2); err == nil {
+		t.Fatalf("Expected an error when shrinking a dedicated cluster by 2 CKUs")
+	}
+}
@@ -0,0 +1,459 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/antihax/optional"
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	paramAcl = "acl"
+
+	kafkaPrincipalAclPolicyLoggingKey = "kafka_principal_acl_policy_id"
+)
+
+func kafkaPrincipalAclPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: kafkaPrincipalAclPolicyCreate,
+		ReadContext:   kafkaPrincipalAclPolicyRead,
+		UpdateContext: kafkaPrincipalAclPolicyUpdate,
+		DeleteContext: kafkaPrincipalAclPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: kafkaPrincipalAclPolicyImport,
+		},
+		CustomizeDiff: kafkaPrincipalAclPolicyCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: optionalKafkaClusterBlockSchema(),
+			paramPrincipal: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The principal the ACL policy applies to.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^User:(sa|u|pool)-"), "the principal must start with 'User:sa-', 'User:u-' or 'User:pool-'."),
+			},
+			paramAcl: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The complete set of Kafka ACLs for the principal. Kafka ACLs for the principal that are not declared in this set are deleted.",
+				Elem:        principalAclPolicyEntrySchema(),
+			},
+			paramRestEndpoint: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The REST endpoint of the Kafka cluster (e.g., `https://pkc-00000.us-central1.gcp.confluent.cloud:443`).",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
+			},
+			paramCredentials: credentialsSchema(),
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultResourceTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Update: schema.DefaultTimeout(defaultResourceTimeout),
+			Delete: schema.DefaultTimeout(defaultResourceTimeout),
+		},
+	}
+}
+
+func principalAclPolicyEntrySchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			paramResourceType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The type of the resource.",
+				ValidateFunc: validation.StringInSlice(acceptedResourceTypes, false),
+			},
+			paramResourceName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The resource name for the ACL.",
+			},
+			paramPatternType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The pattern type for the ACL.",
+				ValidateFunc: validation.StringInSlice(acceptedPatternTypes, false),
+			},
+			paramHost: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     wildcardHost,
+				Description: "The host for the ACL.",
+			},
+			paramOperation: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The operation type for the ACL.",
+				ValidateFunc: validation.StringInSlice(acceptedOperations, false),
+			},
+			paramPermission: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The permission for the ACL.",
+				ValidateFunc: validation.StringInSlice(acceptedPermissions, false),
+			},
+		},
+	}
+}
+
+func kafkaPrincipalAclPolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	for _, entry := range diff.Get(paramAcl).(*schema.Set).List() {
+		entryMap := entry.(map[string]interface{})
+		if err := validateAclOperationForResourceType(entryMap[paramResourceType].(string), entryMap[paramOperation].(string)); err != nil {
+			return fmt.Errorf("invalid %q block: %s", paramAcl, err)
+		}
+	}
+	return nil
+}
+
+func createKafkaRestClientForPrincipalAclPolicy(client *Client, d *schema.ResourceData, clusterId string, isImportOperation bool) (*KafkaRestClient, error) {
+	restEndpoint, err := extractRestEndpoint(client, d, isImportOperation)
+	if err != nil {
+		return nil, err
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(client, d, clusterId, isImportOperation)
+	if err != nil {
+		return nil, err
+	}
+	return client.kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, client.isKafkaMetadataSet), nil
+}
+
+func kafkaPrincipalAclPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Principal ACL Policy: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient, err := createKafkaRestClientForPrincipalAclPolicy(client, d, clusterId, false)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Principal ACL Policy: %s", createDescriptiveError(err))
+	}
+	principal := d.Get(paramPrincipal).(string)
+	desiredAcls, err := extractPrincipalAclPolicyEntries(principal, d.Get(paramAcl).(*schema.Set))
+	if err != nil {
+		return diag.Errorf("error creating Kafka Principal ACL Policy: %s", createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Creating new Kafka Principal ACL Policy for %q: %d Kafka ACLs", principal, len(desiredAcls)))
+
+	if err := reconcileKafkaAclsForPrincipal(ctx, client, kafkaRestClient, principal, desiredAcls, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error creating Kafka Principal ACL Policy: %s", createDescriptiveError(err))
+	}
+	d.SetId(createKafkaPrincipalAclPolicyId(clusterId, principal))
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Kafka Principal ACL Policy %q", d.Id()), map[string]interface{}{kafkaPrincipalAclPolicyLoggingKey: d.Id()})
+
+	return kafkaPrincipalAclPolicyRead(ctx, d, meta)
+}
+
+func kafkaPrincipalAclPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Principal ACL Policy %q", d.Id()), map[string]interface{}{kafkaPrincipalAclPolicyLoggingKey: d.Id()})
+
+	client := meta.(*Client)
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Principal ACL Policy: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient, err := createKafkaRestClientForPrincipalAclPolicy(client, d, clusterId, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Principal ACL Policy: %s", createDescriptiveError(err))
+	}
+	principal := d.Get(paramPrincipal).(string)
+
+	if _, err := readPrincipalAclPolicyAndSetAttributes(ctx, d, client, kafkaRestClient, principal); err != nil {
+		return diag.Errorf("error reading Kafka Principal ACL Policy: %s", createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Kafka Principal ACL Policy %q", d.Id()), map[string]interface{}{kafkaPrincipalAclPolicyLoggingKey: d.Id()})
+
+	return nil
+}
+
+func kafkaPrincipalAclPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChangesExcept(paramCredentials, paramAcl) {
+		return nonUpdatableAttributeErrors(d, kafkaPrincipalAclPolicyResource().Schema, fmt.Sprintf("error updating Kafka Principal ACL Policy %q: only %q block and %q set can be updated for Kafka Principal ACL Policy", d.Id(), paramCredentials, paramAcl), paramCredentials, paramAcl)
+	}
+	if d.HasChange(paramAcl) {
+		client := meta.(*Client)
+		clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Principal ACL Policy %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		kafkaRestClient, err := createKafkaRestClientForPrincipalAclPolicy(client, d, clusterId, false)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Principal ACL Policy %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		principal := d.Get(paramPrincipal).(string)
+		desiredAcls, err := extractPrincipalAclPolicyEntries(principal, d.Get(paramAcl).(*schema.Set))
+		if err != nil {
+			return diag.Errorf("error updating Kafka Principal ACL Policy %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Updating Kafka Principal ACL Policy %q: %d Kafka ACLs", d.Id(), len(desiredAcls)), map[string]interface{}{kafkaPrincipalAclPolicyLoggingKey: d.Id()})
+
+		if err := reconcileKafkaAclsForPrincipal(ctx, client, kafkaRestClient, principal, desiredAcls, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error updating Kafka Principal ACL Policy %q: %s", d.Id(), createDescriptiveError(err))
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Finished updating Kafka Principal ACL Policy %q", d.Id()), map[string]interface{}{kafkaPrincipalAclPolicyLoggingKey: d.Id()})
+	}
+	return kafkaPrincipalAclPolicyRead(ctx, d, meta)
+}
+
+func kafkaPrincipalAclPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Kafka Principal ACL Policy %q", d.Id()), map[string]interface{}{kafkaPrincipalAclPolicyLoggingKey: d.Id()})
+
+	client := meta.(*Client)
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka Principal ACL Policy %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	kafkaRestClient, err := createKafkaRestClientForPrincipalAclPolicy(client, d, clusterId, false)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka Principal ACL Policy %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	principal := d.Get(paramPrincipal).(string)
+
+	// Deleting the policy means the principal is left without any Kafka ACLs
+	if err := reconcileKafkaAclsForPrincipal(ctx, client, kafkaRestClient, principal, nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error deleting Kafka Principal ACL Policy %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Kafka Principal ACL Policy %q", d.Id()), map[string]interface{}{kafkaPrincipalAclPolicyLoggingKey: d.Id()})
+
+	return nil
+}
+
+func kafkaPrincipalAclPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tflog.Debug(ctx, fmt.Sprintf("Importing Kafka Principal ACL Policy %q", d.Id()), map[string]interface{}{kafkaPrincipalAclPolicyLoggingKey: d.Id()})
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("error importing Kafka Principal ACL Policy: invalid format: expected '<Kafka cluster ID>/<principal>'")
+	}
+	clusterId := parts[0]
+	principal := parts[1]
+
+	client := meta.(*Client)
+	kafkaRestClient, err := createKafkaRestClientForPrincipalAclPolicy(client, d, clusterId, true)
+	if err != nil {
+		return nil, fmt.Errorf("error importing Kafka Principal ACL Policy: %s", createDescriptiveError(err))
+	}
+
+	// Mark resource as new to avoid d.Set("") when getting 404
+	d.MarkNewResource()
+	if _, err := readPrincipalAclPolicyAndSetAttributes(ctx, d, client, kafkaRestClient, principal); err != nil {
+		return nil, fmt.Errorf("error importing Kafka Principal ACL Policy %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished importing Kafka Principal ACL Policy %q", d.Id()), map[string]interface{}{kafkaPrincipalAclPolicyLoggingKey: d.Id()})
+	return []*schema.ResourceData{d}, nil
+}
+
+func readPrincipalAclPolicyAndSetAttributes(ctx context.Context, d *schema.ResourceData, client *Client, c *KafkaRestClient, principal string) ([]*schema.ResourceData, error) {
+	remoteAcls, err := loadKafkaAclsForPrincipal(ctx, client, c, principal)
+	if err != nil {
+		return nil, err
+	}
+	remoteAclsJson, err := json.Marshal(remoteAcls)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Kafka ACLs for %q: error marshaling %#v to json: %s", principal, remoteAcls, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Kafka ACLs for %q: %s", principal, remoteAclsJson), map[string]interface{}{kafkaPrincipalAclPolicyLoggingKey: d.Id()})
+
+	if err := setStringAttributeInListBlockOfSizeOne(paramKafkaCluster, paramId, c.clusterId, d); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramPrincipal, principal); err != nil {
+		return nil, err
+	}
+	entries := make([]interface{}, len(remoteAcls))
+	for i, remoteAcl := range remoteAcls {
+		entries[i] = map[string]interface{}{
+			paramResourceType: string(remoteAcl.ResourceType),
+			paramResourceName: remoteAcl.ResourceName,
+			paramPatternType:  string(remoteAcl.PatternType),
+			paramHost:         normalizeAclHost(remoteAcl.Host),
+			paramOperation:    string(remoteAcl.Operation),
+			paramPermission:   string(remoteAcl.Permission),
+		}
+	}
+	if err := d.Set(paramAcl, entries); err != nil {
+		return nil, err
+	}
+	if !c.isMetadataSetInProviderBlock {
+		if !isClusterApiKeyFromEnv(c) {
+			if err := setKafkaCredentials(c.clusterApiKey, c.clusterApiSecret, d); err != nil {
+				return nil, err
+			}
+		}
+		if err := d.Set(paramRestEndpoint, c.restEndpoint); err != nil {
+			return nil, err
+		}
+	}
+	d.SetId(createKafkaPrincipalAclPolicyId(c.clusterId, principal))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Lists all Kafka ACLs of a principal with a resource ID (User:sa-abc123),
+// returned Kafka ACLs use the same principal with a resource ID.
+func loadKafkaAclsForPrincipal(ctx context.Context, client *Client, c *KafkaRestClient, principal string) ([]Acl, error) {
+	// APIF-2038: Kafka REST API only accepts integer ID at the moment
+	principalWithIntegerId, err := principalWithResourceIdToPrincipalWithIntegerId(client, principal)
+	if err != nil {
+		return nil, err
+	}
+	opts := &kafkarestv3.GetKafkaV3AclsOpts{
+		Principal: optional.NewString(principalWithIntegerId),
+	}
+	remoteAcls, _, err := executeKafkaAclRead(ctx, c, opts)
+	if err != nil {
+		return nil, err
+	}
+	acls := make([]Acl, len(remoteAcls.Data))
+	for i, remoteAcl := range remoteAcls.Data {
+		acls[i] = Acl{
+			ResourceType: remoteAcl.ResourceType,
+			ResourceName: remoteAcl.ResourceName,
+			PatternType:  remoteAcl.PatternType,
+			Principal:    principal,
+			Host:         normalizeAclHost(remoteAcl.Host),
+			Operation:    remoteAcl.Operation,
+			Permission:   remoteAcl.Permission,
+		}
+	}
+	return acls, nil
+}
+
+// Creates desired Kafka ACLs that are missing and deletes Kafka ACLs of a principal that are not desired,
+// waiting up to the timeout for each of them to sync
+func reconcileKafkaAclsForPrincipal(ctx context.Context, client *Client, c *KafkaRestClient, principal string, desiredAcls []Acl, timeout time.Duration) error {
+	// APIF-2038: Kafka REST API only accepts integer ID at the moment
+	principalWithIntegerId, err := principalWithResourceIdToPrincipalWithIntegerId(client, principal)
+	if err != nil {
+		return err
+	}
+	remoteAcls, err := loadKafkaAclsForPrincipal(ctx, client, c, principal)
+	if err != nil {
+		return err
+	}
+	aclsToCreate, aclsToDelete := diffKafkaAcls(remoteAcls, desiredAcls)
+
+	// Kafka ACLs that have been created or deleted before a failure are not created or deleted again
+	// on the next run since the remote Kafka ACLs are diffed again
+	for i, acl := range aclsToCreate {
+		tflog.Debug(ctx, fmt.Sprintf("Creating Kafka ACLs %q (%d of %d)", createKafkaAclId(c.clusterId, acl), i+1, len(aclsToCreate)))
+		createAclRequest := kafkarestv3.CreateAclRequestData{
+			ResourceType: acl.ResourceType,
+			ResourceName: acl.ResourceName,
+			PatternType:  acl.PatternType,
+			Principal:    principalWithIntegerId,
+			Host:         acl.Host,
+			Operation:    acl.Operation,
+			Permission:   acl.Permission,
+		}
+		if _, err := executeKafkaAclCreate(ctx, c, createAclRequest); err != nil {
+			return fmt.Errorf("error creating Kafka ACLs %q (%d of %d Kafka ACLs have been created): %s", createKafkaAclId(c.clusterId, acl), i, len(aclsToCreate), createDescriptiveError(err))
+		}
+	}
+	for i, acl := range aclsToDelete {
+		tflog.Debug(ctx, fmt.Sprintf("Deleting Kafka ACLs %q (%d of %d)", createKafkaAclId(c.clusterId, acl), i+1, len(aclsToDelete)))
+		if _, _, err := executeKafkaAclDelete(ctx, c, acl, principalWithIntegerId); err != nil {
+			return fmt.Errorf("error deleting Kafka ACLs %q (%d of %d Kafka ACLs have been deleted): %s", createKafkaAclId(c.clusterId, acl), i, len(aclsToDelete), createDescriptiveError(err))
+		}
+	}
+	for _, acl := range aclsToDelete {
+		if err := waitForKafkaAclToBeDeleted(ctx, c, acl, principalWithIntegerId, timeout); err != nil {
+			return fmt.Errorf("error waiting for Kafka ACLs %q to be deleted: %s", createKafkaAclId(c.clusterId, acl), createDescriptiveError(err))
+		}
+	}
+	for _, acl := range aclsToCreate {
+		if err := waitForCreatedKafkaAclToSync(ctx, c, acl, principalWithIntegerId, timeout); err != nil {
+			return fmt.Errorf("error waiting for Kafka ACLs %q to sync: %s", createKafkaAclId(c.clusterId, acl), createDescriptiveError(err))
+		}
+	}
+	return nil
+}
+
+// Returns Kafka ACLs that are desired but don't exist yet and Kafka ACLs that exist but are not desired
+func diffKafkaAcls(remoteAcls, desiredAcls []Acl) ([]Acl, []Acl) {
+	remoteAclKeys := make(map[string]bool)
+	for _, acl := range remoteAcls {
+		remoteAclKeys[createKafkaAclId("", acl)] = true
+	}
+	desiredAclKeys := make(map[string]bool)
+	for _, acl := range desiredAcls {
+		desiredAclKeys[createKafkaAclId("", acl)] = true
+	}
+	var aclsToCreate, aclsToDelete []Acl
+	for _, acl := range desiredAcls {
+		if !remoteAclKeys[createKafkaAclId("", acl)] {
+			aclsToCreate = append(aclsToCreate, acl)
+		}
+	}
+	for _, acl := range remoteAcls {
+		if !desiredAclKeys[createKafkaAclId("", acl)] {
+			aclsToDelete = append(aclsToDelete, acl)
+		}
+	}
+	return aclsToCreate, aclsToDelete
+}
+
+func extractPrincipalAclPolicyEntries(principal string, entries *schema.Set) ([]Acl, error) {
+	acls := make([]Acl, 0, entries.Len())
+	for _, entry := range entries.List() {
+		entryMap := entry.(map[string]interface{})
+		resourceType, err := stringToAclResourceType(entryMap[paramResourceType].(string))
+		if err != nil {
+			return nil, err
+		}
+		patternType, err := stringToAclPatternType(entryMap[paramPatternType].(string))
+		if err != nil {
+			return nil, err
+		}
+		operation, err := stringToAclOperation(entryMap[paramOperation].(string))
+		if err != nil {
+			return nil, err
+		}
+		permission, err := stringToAclPermission(entryMap[paramPermission].(string))
+		if err != nil {
+			return nil, err
+		}
+		acls = append(acls, Acl{
+			ResourceType: resourceType,
+			ResourceName: entryMap[paramResourceName].(string),
+			PatternType:  patternType,
+			Principal:    principal,
+			Host:         normalizeAclHost(entryMap[paramHost].(string)),
+			Operation:    operation,
+			Permission:   permission,
+		})
+	}
+	return acls, nil
+}
+
+func createKafkaPrincipalAclPolicyId(clusterId, principal string) string {
+	return fmt.Sprintf("%s/%s", clusterId, principal)
+}
@@ -0,0 +1,226 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/walkerus/go-wiremock"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	scenarioStatePrincipalAclPolicyHasBeenCreated = "The Kafka ACLs of the policy have been just created"
+	scenarioStatePrincipalAclPolicyHasBeenDeleted = "The Kafka ACLs of the policy have been deleted"
+	principalAclPolicyScenarioName                = "confluent_kafka_principal_acl_policy Resource Lifecycle"
+	principalAclPolicyResourceLabel               = "test_principal_acl_policy_resource_label"
+)
+
+var fullPrincipalAclPolicyResourceLabel = fmt.Sprintf("confluent_kafka_principal_acl_policy.%s", principalAclPolicyResourceLabel)
+
+func TestAccPrincipalAclPolicy(t *testing.T) {
+	containerPort := "8080"
+	containerPortTcp := fmt.Sprintf("%s/tcp", containerPort)
+	ctx := context.Background()
+	listeningPort := wait.ForListeningPort(nat.Port(containerPortTcp))
+	req := testcontainers.ContainerRequest{
+		Image:        "rodolpheche/wiremock",
+		ExposedPorts: []string{containerPortTcp},
+		WaitingFor:   listeningPort,
+	}
+	wiremockContainer, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+
+	require.NoError(t, err)
+
+	// nolint:errcheck
+	defer wiremockContainer.Terminate(ctx)
+
+	host, err := wiremockContainer.Host(ctx)
+	require.NoError(t, err)
+
+	wiremockHttpMappedPort, err := wiremockContainer.MappedPort(ctx, nat.Port(containerPort))
+	require.NoError(t, err)
+
+	mockServerUrl := fmt.Sprintf("http://%s:%s", host, wiremockHttpMappedPort.Port())
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readServiceAccountsResponse, _ := ioutil.ReadFile("../testdata/kafka_principal_acl_policy/read_service_accounts.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readServiceAccountsPath)).
+		InScenario(principalAclPolicyScenarioName).
+		WillReturn(
+			string(readServiceAccountsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readNoAclsResponse, _ := ioutil.ReadFile("../testdata/kafka_principal_acl_policy/search_principal_no_kafka_acls.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(createKafkaAclPath)).
+		WithQueryParam("principal", wiremock.EqualTo(aclPrincipalWithIntegerId)).
+		InScenario(principalAclPolicyScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readNoAclsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	createAclStub := wiremock.Post(wiremock.URLPathEqualTo(createKafkaAclPath)).
+		InScenario(principalAclPolicyScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStatePrincipalAclPolicyHasBeenCreated).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createAclStub)
+
+	readCreatedAclsResponse, _ := ioutil.ReadFile("../testdata/kafka_principal_acl_policy/search_principal_kafka_acls.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(createKafkaAclPath)).
+		WithQueryParam("principal", wiremock.EqualTo(aclPrincipalWithIntegerId)).
+		InScenario(principalAclPolicyScenarioName).
+		WhenScenarioStateIs(scenarioStatePrincipalAclPolicyHasBeenCreated).
+		WillReturn(
+			string(readCreatedAclsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(createKafkaAclPath)).
+		WithQueryParam("principal", wiremock.EqualTo(aclPrincipalWithIntegerId)).
+		InScenario(principalAclPolicyScenarioName).
+		WhenScenarioStateIs(scenarioStatePrincipalAclPolicyHasBeenDeleted).
+		WillReturn(
+			string(readNoAclsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteAclResponse, _ := ioutil.ReadFile("../testdata/kafka_principal_acl_policy/delete_kafka_acls.json")
+	deleteAclStub := wiremock.Delete(wiremock.URLPathEqualTo(createKafkaAclPath)).
+		WithQueryParam("host", wiremock.EqualTo(aclHost)).
+		WithQueryParam("operation", wiremock.EqualTo(aclOperation)).
+		WithQueryParam("pattern_type", wiremock.EqualTo(aclPatternType)).
+		WithQueryParam("permission", wiremock.EqualTo(aclPermission)).
+		WithQueryParam("principal", wiremock.EqualTo(aclPrincipalWithIntegerId)).
+		WithQueryParam("resource_name", wiremock.EqualTo(aclResourceName)).
+		WithQueryParam("resource_type", wiremock.EqualTo(aclResourceType)).
+		InScenario(principalAclPolicyScenarioName).
+		WhenScenarioStateIs(scenarioStatePrincipalAclPolicyHasBeenCreated).
+		WillSetStateTo(scenarioStatePrincipalAclPolicyHasBeenDeleted).
+		WillReturn(
+			string(deleteAclResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(deleteAclStub)
+
+	// Set fake values for secrets since those are required for importing
+	_ = os.Setenv("IMPORT_KAFKA_API_KEY", kafkaApiKey)
+	_ = os.Setenv("IMPORT_KAFKA_API_SECRET", kafkaApiSecret)
+	_ = os.Setenv("IMPORT_KAFKA_REST_ENDPOINT", mockServerUrl)
+	defer func() {
+		_ = os.Unsetenv("IMPORT_KAFKA_API_KEY")
+		_ = os.Unsetenv("IMPORT_KAFKA_API_SECRET")
+		_ = os.Unsetenv("IMPORT_KAFKA_REST_ENDPOINT")
+	}()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// https://www.terraform.io/docs/extend/testing/acceptance-tests/teststep.html
+		// https://www.terraform.io/docs/extend/best-practices/testing.html#built-in-patterns
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPrincipalAclPolicyConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAclExists(fullPrincipalAclPolicyResourceLabel),
+					resource.TestCheckResourceAttr(fullPrincipalAclPolicyResourceLabel, "id", fmt.Sprintf("%s/%s", clusterId, aclPrincipalWithResourceId)),
+					resource.TestCheckResourceAttr(fullPrincipalAclPolicyResourceLabel, "kafka_cluster.#", "1"),
+					resource.TestCheckResourceAttr(fullPrincipalAclPolicyResourceLabel, "kafka_cluster.0.id", clusterId),
+					resource.TestCheckResourceAttr(fullPrincipalAclPolicyResourceLabel, "principal", aclPrincipalWithResourceId),
+					resource.TestCheckResourceAttr(fullPrincipalAclPolicyResourceLabel, "acl.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(fullPrincipalAclPolicyResourceLabel, "acl.*", map[string]string{
+						"resource_type": aclResourceType,
+						"resource_name": aclResourceName,
+						"pattern_type":  aclPatternType,
+						"host":          aclHost,
+						"operation":     aclOperation,
+						"permission":    aclPermission,
+					}),
+					resource.TestCheckResourceAttr(fullPrincipalAclPolicyResourceLabel, "credentials.#", "1"),
+					resource.TestCheckResourceAttr(fullPrincipalAclPolicyResourceLabel, "credentials.0.key", kafkaApiKey),
+					resource.TestCheckResourceAttr(fullPrincipalAclPolicyResourceLabel, "credentials.0.secret", kafkaApiSecret),
+				),
+			},
+			{
+				// https://www.terraform.io/docs/extend/resources/import.html
+				ResourceName:      fullPrincipalAclPolicyResourceLabel,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createAclStub, fmt.Sprintf("POST %s", createKafkaAclPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteAclStub, fmt.Sprintf("DELETE %s", readKafkaAclPath), expectedCountOne)
+}
+
+func testAccCheckPrincipalAclPolicyConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	resource "confluent_kafka_principal_acl_policy" "%s" {
+	  kafka_cluster {
+        id = "%s"
+      }
+	  principal = "%s"
+
+	  acl {
+	    resource_type = "%s"
+	    resource_name = "%s"
+	    pattern_type = "%s"
+	    operation = "%s"
+	    permission = "%s"
+	  }
+
+	  rest_endpoint = "%s"
+
+	  credentials {
+		key = "%s"
+		secret = "%s"
+	  }
+	}
+	`, mockServerUrl, principalAclPolicyResourceLabel, clusterId, aclPrincipalWithResourceId, aclResourceType, aclResourceName,
+		aclPatternType, aclOperation, aclPermission, mockServerUrl, kafkaApiKey, kafkaApiSecret)
+}
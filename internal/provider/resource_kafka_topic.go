@@ -20,12 +20,14 @@ import (
 	"fmt"
 	"github.com/antihax/optional"
 	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -38,8 +40,12 @@ const (
 	paramKey                    = "key"
 	paramSecret                 = "secret"
 	paramConfigs                = "config"
+	paramManageOnlyDeclared     = "manage_only_declared_configs"
 	kafkaRestAPIWaitAfterCreate = 10 * time.Second
+	kafkaTopicDeleteTimeout     = 1 * time.Hour
 	docsUrl                     = "https://registry.terraform.io/providers/confluentinc/confluent/latest/docs/resources/confluent_kafka_topic"
+	// Kafka REST API error code of TopicExistsException
+	kafkaRestTopicAlreadyExistsErrorCode = 40002
 )
 
 // https://docs.confluent.io/cloud/current/clusters/broker-config.html#custom-topic-settings-for-all-cluster-types
@@ -79,7 +85,7 @@ func kafkaTopicResource() *schema.Resource {
 			StateContext: kafkaTopicImport,
 		},
 		Schema: map[string]*schema.Schema{
-			paramKafkaCluster: kafkaClusterBlockSchema(),
+			paramKafkaCluster: optionalKafkaClusterBlockSchema(),
 			paramTopicName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -107,12 +113,25 @@ func kafkaTopicResource() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
+				Optional:         true,
+				Computed:         true,
+				Description:      "The custom topic settings to set (e.g., `\"cleanup.policy\" = \"compact\"`).",
+				ValidateDiagFunc: validateTopicRetentionConfigs,
+			},
+			paramManageOnlyDeclared: {
+				Type:        schema.TypeBool,
 				Optional:    true,
-				Computed:    true,
-				Description: "The custom topic settings to set (e.g., `\"cleanup.policy\" = \"compact\"`).",
+				Default:     false,
+				Description: "Whether the provider should ignore dynamic topic settings that are not declared in the `config` block, for example, settings tuned by external tools.",
 			},
 			paramCredentials: credentialsSchema(),
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultResourceTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Update: schema.DefaultTimeout(defaultResourceTimeout),
+			Delete: schema.DefaultTimeout(kafkaTopicDeleteTimeout),
+		},
 		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -148,7 +167,22 @@ func extractRestEndpoint(client *Client, d *schema.ResourceData, isImportOperati
 	return "", fmt.Errorf("one of provider.kafka_rest_endpoint (defaults to KAFKA_REST_ENDPOINT environment variable) or resource.rest_endpoint must be set")
 }
 
-func extractClusterApiKeyAndApiSecret(client *Client, d *schema.ResourceData, isImportOperation bool) (string, string, error) {
+func extractKafkaClusterId(client *Client, d *schema.ResourceData) (string, error) {
+	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
+	if client.kafkaClusterId == "" {
+		if clusterId != "" {
+			return clusterId, nil
+		}
+		return "", fmt.Errorf("one of provider.kafka_id (defaults to KAFKA_ID environment variable) or resource.kafka_cluster.id must be set")
+	}
+	if clusterId != "" && clusterId != client.kafkaClusterId {
+		return "", fmt.Errorf("resource.kafka_cluster.id (%q) must be equal to provider.kafka_id (%q) if both are set", clusterId, client.kafkaClusterId)
+	}
+	return client.kafkaClusterId, nil
+}
+
+// clusterId is used for looking up per-cluster environment variables, it is empty if it's not known yet
+func extractClusterApiKeyAndApiSecret(client *Client, d *schema.ResourceData, clusterId string, isImportOperation bool) (string, string, error) {
 	if client.isKafkaMetadataSet {
 		return client.kafkaApiKey, client.kafkaApiSecret, nil
 	}
@@ -157,15 +191,67 @@ func extractClusterApiKeyAndApiSecret(client *Client, d *schema.ResourceData, is
 		clusterApiSecret := getEnv("IMPORT_KAFKA_API_SECRET", "")
 		if clusterApiKey != "" && clusterApiSecret != "" {
 			return clusterApiKey, clusterApiSecret, nil
+		} else if clusterApiKey, clusterApiSecret := extractClusterApiKeyAndApiSecretFromEnv(clusterId); clusterApiKey != "" {
+			return clusterApiKey, clusterApiSecret, nil
+		} else if client.oauthToken != nil || client.mdsToken != nil {
+			// OAuth or MDS tokens are used instead of Kafka API Keys
+			return "", "", nil
 		} else {
-			return "", "", fmt.Errorf("one of (provider.kafka_api_key, provider.kafka_api_secret), (KAFKA_API_KEY, KAFKA_API_SECRET environment variables) or (IMPORT_KAFKA_API_KEY, IMPORT_KAFKA_API_SECRET environment variables) must be set")
+			return "", "", fmt.Errorf("one of (provider.kafka_api_key, provider.kafka_api_secret, provider.kafka_rest_endpoint), (KAFKA_API_KEY, KAFKA_API_SECRET, KAFKA_REST_ENDPOINT environment variables), "+
+				"(%s, %s environment variables) or (IMPORT_KAFKA_API_KEY, IMPORT_KAFKA_API_SECRET environment variables) must be set", clusterApiKeyEnvVar(clusterIdOrPlaceholder(clusterId)), clusterApiSecretEnvVar(clusterIdOrPlaceholder(clusterId)))
 		}
 	}
 	clusterApiKey, clusterApiSecret := extractClusterApiKeyAndApiSecretFromCredentialsBlock(d)
 	if clusterApiKey != "" {
 		return clusterApiKey, clusterApiSecret, nil
 	}
-	return "", "", fmt.Errorf("one of (provider.kafka_api_key, provider.kafka_api_secret), (KAFKA_API_KEY, KAFKA_API_SECRET environment variables) or (resource.credentials.key, resource.credentials.secret) must be set")
+	// Keeps Kafka API Keys out of the configuration and the state when the credentials block is omitted
+	if clusterApiKey, clusterApiSecret := extractClusterApiKeyAndApiSecretFromEnv(clusterId); clusterApiKey != "" {
+		return clusterApiKey, clusterApiSecret, nil
+	}
+	if client.oauthToken != nil || client.mdsToken != nil {
+		return "", "", nil
+	}
+	// KAFKA_API_KEY and KAFKA_API_SECRET are only read as provider.kafka_api_key and provider.kafka_api_secret,
+	// which must be set together with provider.kafka_rest_endpoint
+	return "", "", fmt.Errorf("one of (provider.kafka_api_key, provider.kafka_api_secret, provider.kafka_rest_endpoint), (KAFKA_API_KEY, KAFKA_API_SECRET, KAFKA_REST_ENDPOINT environment variables), "+
+		"(%s, %s environment variables) or (resource.credentials.key, resource.credentials.secret) must be set", clusterApiKeyEnvVar(clusterIdOrPlaceholder(clusterId)), clusterApiSecretEnvVar(clusterIdOrPlaceholder(clusterId)))
+}
+
+// Returns the Kafka API Key and Secret set in per-cluster environment variables, for example,
+// KAFKA_API_KEY_lkcabc123 and KAFKA_API_SECRET_lkcabc123 for the lkc-abc123 Kafka cluster
+func extractClusterApiKeyAndApiSecretFromEnv(clusterId string) (string, string) {
+	if clusterId == "" {
+		return "", ""
+	}
+	clusterApiKey := getEnv(clusterApiKeyEnvVar(clusterId), "")
+	clusterApiSecret := getEnv(clusterApiSecretEnvVar(clusterId), "")
+	if clusterApiKey == "" || clusterApiSecret == "" {
+		return "", ""
+	}
+	return clusterApiKey, clusterApiSecret
+}
+
+// Environment variable names can't contain dashes
+func clusterApiKeyEnvVar(clusterId string) string {
+	return "KAFKA_API_KEY_" + strings.ReplaceAll(clusterId, "-", "")
+}
+
+func clusterApiSecretEnvVar(clusterId string) string {
+	return "KAFKA_API_SECRET_" + strings.ReplaceAll(clusterId, "-", "")
+}
+
+// Kafka API Keys that are read from per-cluster environment variables are not saved in the state
+func isClusterApiKeyFromEnv(c *KafkaRestClient) bool {
+	clusterApiKey, _ := extractClusterApiKeyAndApiSecretFromEnv(c.clusterId)
+	return clusterApiKey != "" && clusterApiKey == c.clusterApiKey
+}
+
+func clusterIdOrPlaceholder(clusterId string) string {
+	if clusterId == "" {
+		return "<Kafka cluster ID>"
+	}
+	return clusterId
 }
 
 func kafkaTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -173,8 +259,11 @@ func kafkaTopicCreate(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err != nil {
 		return diag.Errorf("error creating Kafka Topic: %s", createDescriptiveError(err))
 	}
-	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
-	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Topic: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, clusterId, false)
 	if err != nil {
 		return diag.Errorf("error creating Kafka Topic: %s", createDescriptiveError(err))
 	}
@@ -192,9 +281,16 @@ func kafkaTopicCreate(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Creating new Kafka Topic: %s", createTopicRequestJson))
 
+	var diags diag.Diagnostics
 	createdKafkaTopic, _, err := executeKafkaTopicCreate(ctx, kafkaRestClient, createTopicRequest)
-
-	if err != nil {
+	if err != nil && errorResponseCode(err) == kafkaRestTopicAlreadyExistsErrorCode {
+		// An interrupted apply or a retried request might have created the topic already, so adopt it
+		// instead of failing as long as it matches the configuration
+		createdKafkaTopic, diags = adoptExistingKafkaTopic(ctx, kafkaRestClient, createTopicRequest)
+		if diags.HasError() {
+			return diags
+		}
+	} else if err != nil {
 		return diag.Errorf("error creating Kafka Topic: %s", createDescriptiveError(err))
 	}
 
@@ -210,7 +306,24 @@ func kafkaTopicCreate(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Finished creating Kafka Topic %q: %s", d.Id(), createdKafkaTopicJson), map[string]interface{}{kafkaTopicLoggingKey: d.Id()})
 
-	return kafkaTopicRead(ctx, d, meta)
+	return append(diags, kafkaTopicRead(ctx, d, meta)...)
+}
+
+func adoptExistingKafkaTopic(ctx context.Context, c *KafkaRestClient, requestData kafkarestv3.CreateTopicRequestData) (kafkarestv3.TopicData, diag.Diagnostics) {
+	existingKafkaTopic, _, err := c.apiClient.TopicV3Api.GetKafkaV3Topic(c.apiContext(ctx), c.clusterId, requestData.TopicName)
+	if err != nil {
+		return existingKafkaTopic, diag.Errorf("error creating Kafka Topic %q: the topic already exists but couldn't be read: %s", requestData.TopicName, createDescriptiveError(err))
+	}
+	if existingKafkaTopic.PartitionsCount != requestData.PartitionsCount {
+		return existingKafkaTopic, diag.Errorf("error creating Kafka Topic %q: the topic already exists with %d partitions instead of %d, "+
+			"import it with `terraform import` or choose another %q", requestData.TopicName, existingKafkaTopic.PartitionsCount, requestData.PartitionsCount, paramTopicName)
+	}
+	tflog.Warn(ctx, fmt.Sprintf("Adopting existing Kafka Topic %q", requestData.TopicName), map[string]interface{}{kafkaTopicLoggingKey: createKafkaTopicId(c.clusterId, requestData.TopicName)})
+	return existingKafkaTopic, diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Adopted existing Kafka Topic %q", requestData.TopicName),
+		Detail:   "The topic already existed with the same number of partitions, most likely because a previous apply was interrupted before it was saved to the state, so it was added to the state instead of being created. Its settings are updated on the next apply if they differ from the configuration.",
+	}}
 }
 
 func executeKafkaTopicCreate(ctx context.Context, c *KafkaRestClient, requestData kafkarestv3.CreateTopicRequestData) (kafkarestv3.TopicData, *http.Response, error) {
@@ -227,8 +340,11 @@ func kafkaTopicDelete(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err != nil {
 		return diag.Errorf("error deleting Kafka Topic: %s", createDescriptiveError(err))
 	}
-	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
-	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka Topic: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, clusterId, false)
 	if err != nil {
 		return diag.Errorf("error deleting Kafka Topic: %s", createDescriptiveError(err))
 	}
@@ -241,7 +357,7 @@ func kafkaTopicDelete(ctx context.Context, d *schema.ResourceData, meta interfac
 		return diag.Errorf("error deleting Kafka Topic %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
-	if err := waitForKafkaTopicToBeDeleted(kafkaRestClient.apiContext(ctx), kafkaRestClient, topicName); err != nil {
+	if err := waitForKafkaTopicToBeDeleted(kafkaRestClient.apiContext(ctx), kafkaRestClient, topicName, d.Timeout(schema.TimeoutDelete)); err != nil {
 		return diag.Errorf("error waiting for Kafka Topic %q to be deleted: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -257,8 +373,11 @@ func kafkaTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{
 	if err != nil {
 		return diag.Errorf("error reading Kafka Topic: %s", createDescriptiveError(err))
 	}
-	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
-	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Topic: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, clusterId, false)
 	if err != nil {
 		return diag.Errorf("error reading Kafka Topic: %s", createDescriptiveError(err))
 	}
@@ -294,20 +413,29 @@ func credentialsSchema() *schema.Schema {
 					Required:     true,
 					Description:  "The Cluster API Key for your Confluent Cloud cluster.",
 					Sensitive:    true,
-					ValidateFunc: validation.StringIsNotEmpty,
+					ValidateFunc: validateCredential(0, false),
 				},
 				paramSecret: {
 					Type:         schema.TypeString,
 					Required:     true,
 					Description:  "The Cluster API Secret for your Confluent Cloud cluster.",
 					Sensitive:    true,
-					ValidateFunc: validation.StringIsNotEmpty,
+					ValidateFunc: validateCredential(0, false),
 				},
 			},
 		},
 	}
 }
 
+// Same as kafkaClusterBlockSchema but the block might be omitted when provider.kafka_id is set
+func optionalKafkaClusterBlockSchema() *schema.Schema {
+	kafkaClusterBlock := kafkaClusterBlockSchema()
+	kafkaClusterBlock.Required = false
+	kafkaClusterBlock.Optional = true
+	kafkaClusterBlock.Computed = true
+	return kafkaClusterBlock
+}
+
 func kafkaClusterBlockSchema() *schema.Schema {
 	return &schema.Schema{
 		Type: schema.TypeList,
@@ -318,7 +446,7 @@ func kafkaClusterBlockSchema() *schema.Schema {
 					Required:     true,
 					ForceNew:     true,
 					Description:  "The Kafka cluster ID (e.g., `lkc-12345`).",
-					ValidateFunc: validation.StringMatch(regexp.MustCompile("^lkc-"), "the Kafka cluster ID must be of the form 'lkc-'"),
+					ValidateFunc: validateKafkaClusterId,
 				},
 			},
 		},
@@ -329,13 +457,16 @@ func kafkaClusterBlockSchema() *schema.Schema {
 	}
 }
 
+// Confluent Cloud cluster IDs start with "lkc-" while Confluent Platform cluster IDs are 22 characters long base64 strings
+var validateKafkaClusterId = skipInMockEndpointMode(validation.StringMatch(regexp.MustCompile("^(lkc-|[A-Za-z0-9_-]{22}$)"), "the Kafka cluster ID must be of the form 'lkc-' or be a Confluent Platform cluster ID"))
+
 func kafkaClusterIdSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:         schema.TypeString,
 		Required:     true,
 		ForceNew:     true,
 		Description:  "The Kafka cluster ID (e.g., `lkc-12345`).",
-		ValidateFunc: validation.StringMatch(regexp.MustCompile("^lkc-"), "the Kafka cluster ID must be of the form 'lkc-'"),
+		ValidateFunc: validateKafkaClusterId,
 	}
 }
 
@@ -346,7 +477,7 @@ func kafkaTopicImport(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err != nil {
 		return nil, fmt.Errorf("error importing Kafka Topic: %s", createDescriptiveError(err))
 	}
-	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, true)
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, "", true)
 	if err != nil {
 		return nil, fmt.Errorf("error importing Kafka Topic: %s", createDescriptiveError(err))
 	}
@@ -405,13 +536,23 @@ func readTopicAndSetAttributes(ctx context.Context, d *schema.ResourceData, c *K
 	if err != nil {
 		return nil, err
 	}
+	if d.Get(paramManageOnlyDeclared).(bool) {
+		// Keys of 'config' block in TF state are the topic settings managed by TF
+		configs = filterDeclaredTopicConfigs(configs, d.Get(paramConfigs).(map[string]interface{}))
+	}
 	if err := d.Set(paramConfigs, configs); err != nil {
 		return nil, err
 	}
+	// Explicitly set the default value to avoid a diff after importing a Kafka Topic
+	if err := d.Set(paramManageOnlyDeclared, d.Get(paramManageOnlyDeclared).(bool)); err != nil {
+		return nil, err
+	}
 
 	if !c.isMetadataSetInProviderBlock {
-		if err := setKafkaCredentials(c.clusterApiKey, c.clusterApiSecret, d); err != nil {
-			return nil, err
+		if !isClusterApiKeyFromEnv(c) {
+			if err := setKafkaCredentials(c.clusterApiKey, c.clusterApiSecret, d); err != nil {
+				return nil, err
+			}
 		}
 		if err := d.Set(paramRestEndpoint, c.restEndpoint); err != nil {
 			return nil, err
@@ -424,8 +565,8 @@ func readTopicAndSetAttributes(ctx context.Context, d *schema.ResourceData, c *K
 }
 
 func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramCredentials, paramConfigs) {
-		return diag.Errorf("error updating Kafka Topic %q: only %q and %q blocks can be updated for Kafka Topic", d.Id(), paramCredentials, paramConfigs)
+	if d.HasChangesExcept(paramCredentials, paramConfigs, paramManageOnlyDeclared) {
+		return nonUpdatableAttributeErrors(d, kafkaTopicResource().Schema, fmt.Sprintf("error updating Kafka Topic %q: only %q, %q blocks and %q attribute can be updated for Kafka Topic", d.Id(), paramCredentials, paramConfigs, paramManageOnlyDeclared), paramCredentials, paramConfigs, paramManageOnlyDeclared)
 	}
 	if d.HasChange(paramConfigs) {
 		// TF Provider allows the following operations for editable topic settings under 'config' block:
@@ -442,7 +583,7 @@ func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 		// Verify that no topic settings were removed (reset to its default value) in TF configuration which is an unsupported operation at the moment
 		for oldTopicSettingName := range oldTopicSettingsMap {
 			if _, ok := newTopicSettingsMap[oldTopicSettingName]; !ok {
-				return diag.Errorf("error updating Kafka Topic %q: reset to topic setting's default value operation (in other words, removing topic settings from 'configs' block) "+
+				return attributeError(cty.GetAttrPath(paramConfigs).IndexString(oldTopicSettingName), "error updating Kafka Topic %q: reset to topic setting's default value operation (in other words, removing topic settings from 'configs' block) "+
 					"is not supported at the moment. "+
 					"Instead, find its default value at %s and set its current value to the default value.", d.Id(), docsUrl)
 			}
@@ -465,7 +606,7 @@ func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 						Value: ptr(newTopicSettingValue),
 					})
 				} else {
-					return diag.Errorf("error updating Kafka Topic %q: %q topic setting is read-only and cannot be updated. "+
+					return attributeError(cty.GetAttrPath(paramConfigs).IndexString(topicSettingName), "error updating Kafka Topic %q: %q topic setting is read-only and cannot be updated. "+
 						"Read %s for more details.", d.Id(), topicSettingName, docsUrl)
 				}
 			}
@@ -479,8 +620,11 @@ func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 		if err != nil {
 			return diag.Errorf("error updating Kafka Topic: %s", createDescriptiveError(err))
 		}
-		clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
-		clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+		clusterId, err := extractKafkaClusterId(meta.(*Client), d)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Topic: %s", createDescriptiveError(err))
+		}
+		clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, clusterId, false)
 		if err != nil {
 			return diag.Errorf("error updating Kafka Topic: %s", createDescriptiveError(err))
 		}
@@ -573,6 +717,71 @@ func loadTopicConfigs(ctx context.Context, d *schema.ResourceData, c *KafkaRestC
 	return config, nil
 }
 
+// Removes topic settings that are not declared in TF configuration
+func filterDeclaredTopicConfigs(remoteConfigs map[string]string, declaredConfigs map[string]interface{}) map[string]string {
+	filteredConfigs := make(map[string]string)
+	for name, value := range remoteConfigs {
+		if _, ok := declaredConfigs[name]; ok {
+			filteredConfigs[name] = value
+		}
+	}
+	return filteredConfigs
+}
+
+// Warns about combinations of retention settings that are valid but rarely do what was intended
+func validateTopicRetentionConfigs(i interface{}, path cty.Path) diag.Diagnostics {
+	configs, ok := i.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	cleanupPolicy, _ := configs["cleanup.policy"].(string)
+	retentionMs, isRetentionMsSet := parseTopicConfigInt(configs, "retention.ms")
+	retentionBytes, isRetentionBytesSet := parseTopicConfigInt(configs, "retention.bytes")
+	isCompacted := strings.Contains(cleanupPolicy, "compact")
+	isDeleted := cleanupPolicy == "" || strings.Contains(cleanupPolicy, "delete")
+
+	var diags diag.Diagnostics
+	warn := func(summary, detail string) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       summary,
+			Detail:        detail,
+			AttributePath: path,
+		})
+	}
+	if isCompacted && !isDeleted && (isRetentionMsSet || isRetentionBytesSet) {
+		warn("retention.ms and retention.bytes have no effect on compacted topics",
+			"The topic uses \"cleanup.policy\" = \"compact\" so old segments are compacted rather than deleted and retention.ms and retention.bytes are ignored. "+
+				"Use \"cleanup.policy\" = \"compact,delete\" to delete compacted segments as well.")
+	}
+	if isCompacted && isDeleted && isRetentionMsSet && retentionMs > 0 {
+		warn("Compacted records are deleted after retention.ms",
+			fmt.Sprintf("The topic uses \"cleanup.policy\" = %q so the latest record of every key is deleted as well once it's older than retention.ms (%d ms).", cleanupPolicy, retentionMs))
+	}
+	if isDeleted && isRetentionMsSet && isRetentionBytesSet && retentionMs > 0 && retentionBytes > 0 {
+		warn("Both retention.ms and retention.bytes are set",
+			"Segments are deleted as soon as either limit is exceeded. Note that retention.bytes limits the size of every partition rather than the size of the topic.")
+	}
+	if isDeleted && !isCompacted && isRetentionMsSet && isRetentionBytesSet && retentionMs == -1 && retentionBytes == -1 {
+		warn("Records are never deleted",
+			"Both retention.ms and retention.bytes are set to -1 so the topic keeps growing indefinitely.")
+	}
+	return diags
+}
+
+// Returns false for settings that are not set or whose values are not known yet
+func parseTopicConfigInt(configs map[string]interface{}, name string) (int64, bool) {
+	value, ok := configs[name].(string)
+	if !ok {
+		return 0, false
+	}
+	parsedValue, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return parsedValue, true
+}
+
 func extractOldAndNewTopicSettings(d *schema.ResourceData) (map[string]string, map[string]string) {
 	oldConfigs, newConfigs := d.GetChange(paramConfigs)
 	return convertToStringStringMap(oldConfigs.(map[string]interface{})), convertToStringStringMap(newConfigs.(map[string]interface{}))
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/docker/go-connections/nat"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
@@ -25,7 +26,9 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -352,3 +355,96 @@ func testAccCheckTopicExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestFilterDeclaredTopicConfigs(t *testing.T) {
+	remoteConfigs := map[string]string{
+		"retention.ms":        "600000",
+		"min.insync.replicas": "2",
+	}
+	declaredConfigs := map[string]interface{}{
+		"retention.ms": "600000",
+	}
+	expected := map[string]string{
+		"retention.ms": "600000",
+	}
+	actual := filterDeclaredTopicConfigs(remoteConfigs, declaredConfigs)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
+	}
+}
+
+func TestValidateTopicRetentionConfigs(t *testing.T) {
+	testCases := []struct {
+		configs          map[string]interface{}
+		expectedWarnings int
+	}{
+		{map[string]interface{}{"retention.ms": "604800000"}, 0},
+		{map[string]interface{}{"cleanup.policy": "compact", "retention.ms": "604800000"}, 1},
+		{map[string]interface{}{"cleanup.policy": "compact,delete", "retention.ms": "604800000"}, 1},
+		{map[string]interface{}{"cleanup.policy": "delete", "retention.ms": "604800000", "retention.bytes": "1073741824"}, 1},
+		{map[string]interface{}{"retention.ms": "-1", "retention.bytes": "-1"}, 1},
+		// Values that are not known yet are skipped
+		{map[string]interface{}{"cleanup.policy": "compact", "retention.ms": "74D93920-ED26-11E3-AC10-0800200C9A66"}, 0},
+	}
+	for _, testCase := range testCases {
+		diags := validateTopicRetentionConfigs(testCase.configs, nil)
+		if diags.HasError() {
+			t.Fatalf("expected only warnings for %v, got %v", testCase.configs, diags)
+		}
+		if len(diags) != testCase.expectedWarnings {
+			t.Fatalf("expected %d warnings for %v, got %d: %v", testCase.expectedWarnings, testCase.configs, len(diags), diags)
+		}
+	}
+}
+
+func TestExtractKafkaClusterId(t *testing.T) {
+	resourceSchema := kafkaTopicResource().Schema
+	withBlock := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		paramKafkaCluster: []interface{}{map[string]interface{}{paramId: "lkc-abc123"}},
+	})
+	withoutBlock := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+
+	if clusterId, err := extractKafkaClusterId(&Client{}, withBlock); err != nil || clusterId != "lkc-abc123" {
+		t.Fatalf("expected %q, got %q, %v", "lkc-abc123", clusterId, err)
+	}
+	if clusterId, err := extractKafkaClusterId(&Client{kafkaClusterId: "lkc-abc123"}, withoutBlock); err != nil || clusterId != "lkc-abc123" {
+		t.Fatalf("expected %q, got %q, %v", "lkc-abc123", clusterId, err)
+	}
+	if _, err := extractKafkaClusterId(&Client{}, withoutBlock); err == nil {
+		t.Fatalf("expected an error when the Kafka cluster ID is not set")
+	}
+	if _, err := extractKafkaClusterId(&Client{kafkaClusterId: "lkc-xyz789"}, withBlock); err == nil {
+		t.Fatalf("expected an error when the Kafka cluster IDs are different")
+	}
+}
+
+func TestExtractClusterApiKeyAndApiSecretFromEnv(t *testing.T) {
+	t.Setenv("KAFKA_API_KEY_lkcabc123", "KAFKAAPIKEY12345")
+	t.Setenv("KAFKA_API_SECRET_lkcabc123", "secret")
+	t.Setenv("KAFKA_API_KEY_lkcxyz123", "KAFKAAPIKEY67890")
+
+	if key, secret := extractClusterApiKeyAndApiSecretFromEnv("lkc-abc123"); key != "KAFKAAPIKEY12345" || secret != "secret" {
+		t.Fatalf("expected Kafka API Key and Secret from per-cluster environment variables, got %q, %q", key, secret)
+	}
+	// The secret is missing
+	if key, secret := extractClusterApiKeyAndApiSecretFromEnv("lkc-xyz123"); key != "" || secret != "" {
+		t.Fatalf("expected no Kafka API Key and Secret, got %q, %q", key, secret)
+	}
+	if key, secret := extractClusterApiKeyAndApiSecretFromEnv(""); key != "" || secret != "" {
+		t.Fatalf("expected no Kafka API Key and Secret for an unknown Kafka cluster, got %q, %q", key, secret)
+	}
+	if !isClusterApiKeyFromEnv(&KafkaRestClient{clusterId: "lkc-abc123", clusterApiKey: "KAFKAAPIKEY12345"}) {
+		t.Fatalf("expected the Kafka API Key to be detected as read from environment variables")
+	}
+	if isClusterApiKeyFromEnv(&KafkaRestClient{clusterId: "lkc-abc123", clusterApiKey: "OTHERKAFKAAPIKEY"}) {
+		t.Fatalf("expected the Kafka API Key not to be detected as read from environment variables")
+	}
+}
+
+func TestExtractClusterApiKeyAndApiSecretErrorListsKafkaRestEndpoint(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, kafkaTopicResource().Schema, map[string]interface{}{paramTopicName: "orders"})
+	_, _, err := extractClusterApiKeyAndApiSecret(&Client{}, d, "lkc-abc123", false)
+	if err == nil || !strings.Contains(err.Error(), "KAFKA_API_KEY, KAFKA_API_SECRET, KAFKA_REST_ENDPOINT environment variables") || !strings.Contains(err.Error(), "KAFKA_API_KEY_lkcabc123") {
+		t.Fatalf("expected an error that lists the environment variables that are read, got %v", err)
+	}
+}
@@ -88,7 +88,7 @@ func networkResource() *schema.Resource {
 				ForceNew:     true,
 			},
 			paramZones:       zonesSchema(),
-			paramEnvironment: environmentSchema(),
+			paramEnvironment: environmentSchemaWithDefault(),
 			paramResourceName: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -113,6 +113,8 @@ func networkResource() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(networkingAPICreateTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Update: schema.DefaultTimeout(defaultResourceTimeout),
 			Delete: schema.DefaultTimeout(networkingAPIDeleteTimeout),
 		},
 	}
@@ -240,7 +242,7 @@ func networkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(createdNetwork.GetId())
 
-	if err := waitForNetworkToProvision(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForNetworkToProvision(c.netApiContext(ctx), c, environmentId, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("error waiting for Network %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -75,10 +75,12 @@ func peeringResource() *schema.Resource {
 			paramAzure:       azurePeeringSchema(),
 			paramGcp:         gcpPeeringSchema(),
 			paramNetwork:     requiredNetworkSchema(),
-			paramEnvironment: environmentSchema(),
+			paramEnvironment: environmentSchemaWithDefault(),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(networkingAPICreateTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Update: schema.DefaultTimeout(defaultResourceTimeout),
 			Delete: schema.DefaultTimeout(networkingAPIDeleteTimeout),
 		},
 	}
@@ -136,7 +138,7 @@ func peeringCreate(ctx context.Context, d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(createdPeering.GetId())
 
-	if err := waitForPeeringToProvision(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForPeeringToProvision(c.netApiContext(ctx), c, environmentId, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("error waiting for Peering %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -256,7 +258,7 @@ func peeringDelete(ctx context.Context, d *schema.ResourceData, meta interface{}
 		return diag.Errorf("error deleting Peering %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
-	if err := waitForPeeringToBeDeleted(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForPeeringToBeDeleted(c.netApiContext(ctx), c, environmentId, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return diag.Errorf("error waiting for Peering %q to be deleted: %s", d.Id(), createDescriptiveError(err))
 	}
 
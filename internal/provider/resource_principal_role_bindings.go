@@ -0,0 +1,309 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	mds "github.com/confluentinc/ccloud-sdk-go-v2/mds/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+)
+
+const (
+	paramRoleBinding = "role_binding"
+
+	principalRoleBindingsLoggingKey = "principal_role_bindings_id"
+
+	// The maximum allowable page size - 1 (to avoid off-by-one errors) when listing role bindings using IAM V2 API
+	// https://docs.confluent.io/cloud/current/api.html#operation/listIamV2RoleBindings
+	listRoleBindingsPageSize = 99
+)
+
+func principalRoleBindingsResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: principalRoleBindingsCreate,
+		ReadContext:   principalRoleBindingsRead,
+		UpdateContext: principalRoleBindingsUpdate,
+		DeleteContext: principalRoleBindingsDelete,
+		Schema: map[string]*schema.Schema{
+			paramPrincipal: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The principal User to bind the roles to.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^User:"), "the Principal must be of the form 'User:'"),
+			},
+			paramRoleBinding: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The complete set of Role Bindings for the principal. Role Bindings for the principal on the declared CRN patterns that are not declared in this set are deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramRoleName: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the role to bind to the principal.",
+						},
+						paramCrnPattern: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "A CRN that specifies the scope and resource patterns necessary for the role to bind.",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile("^crn://"), "the CRN must be of the form 'crn://'"),
+						},
+					},
+				},
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultResourceTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Update: schema.DefaultTimeout(defaultResourceTimeout),
+			Delete: schema.DefaultTimeout(defaultResourceTimeout),
+		},
+	}
+}
+
+// A role binding of a principal without its ID: (role name, CRN pattern)
+type principalRoleBinding [2]string
+
+func principalRoleBindingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*Client)
+
+	principal := d.Get(paramPrincipal).(string)
+	desiredRoleBindings := extractPrincipalRoleBindings(d.Get(paramRoleBinding).(*schema.Set))
+	tflog.Debug(ctx, fmt.Sprintf("Creating new Principal Role Bindings for %q: %d Role Bindings", principal, len(desiredRoleBindings)))
+
+	if err := reconcileRoleBindingsForPrincipal(ctx, c, principal, nil, desiredRoleBindings); err != nil {
+		return diag.Errorf("error creating Principal Role Bindings: %s", createDescriptiveError(err))
+	}
+	d.SetId(principal)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Principal Role Bindings %q", d.Id()), map[string]interface{}{principalRoleBindingsLoggingKey: d.Id()})
+
+	return principalRoleBindingsRead(ctx, d, meta)
+}
+
+func principalRoleBindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Principal Role Bindings %q", d.Id()), map[string]interface{}{principalRoleBindingsLoggingKey: d.Id()})
+	c := meta.(*Client)
+
+	principal := d.Get(paramPrincipal).(string)
+	crnPatterns := principalRoleBindingCrnPatterns(extractPrincipalRoleBindings(d.Get(paramRoleBinding).(*schema.Set)))
+	remoteRoleBindings, err := loadRoleBindingsForPrincipal(ctx, c, principal, crnPatterns)
+	if err != nil {
+		return diag.Errorf("error reading Principal Role Bindings %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	remoteRoleBindingsJson, err := json.Marshal(remoteRoleBindings)
+	if err != nil {
+		return diag.Errorf("error reading Principal Role Bindings %q: error marshaling %#v to json: %s", d.Id(), remoteRoleBindings, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Role Bindings for %q: %s", principal, remoteRoleBindingsJson), map[string]interface{}{principalRoleBindingsLoggingKey: d.Id()})
+
+	entries := make([]interface{}, len(remoteRoleBindings))
+	for i, remoteRoleBinding := range remoteRoleBindings {
+		entries[i] = map[string]interface{}{
+			paramRoleName:   remoteRoleBinding.GetRoleName(),
+			paramCrnPattern: remoteRoleBinding.GetCrnPattern(),
+		}
+	}
+	if err := d.Set(paramPrincipal, principal); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	if err := d.Set(paramRoleBinding, entries); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Principal Role Bindings %q", d.Id()), map[string]interface{}{principalRoleBindingsLoggingKey: d.Id()})
+
+	return nil
+}
+
+func principalRoleBindingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChangesExcept(paramRoleBinding) {
+		return diag.Errorf("error updating Principal Role Bindings %q: only %q set can be updated for Principal Role Bindings", d.Id(), paramRoleBinding)
+	}
+	if d.HasChange(paramRoleBinding) {
+		c := meta.(*Client)
+		principal := d.Get(paramPrincipal).(string)
+		oldRoleBindings, newRoleBindings := d.GetChange(paramRoleBinding)
+		currentRoleBindings := extractPrincipalRoleBindings(oldRoleBindings.(*schema.Set))
+		desiredRoleBindings := extractPrincipalRoleBindings(newRoleBindings.(*schema.Set))
+		tflog.Debug(ctx, fmt.Sprintf("Updating Principal Role Bindings %q: %d Role Bindings", d.Id(), len(desiredRoleBindings)), map[string]interface{}{principalRoleBindingsLoggingKey: d.Id()})
+
+		if err := reconcileRoleBindingsForPrincipal(ctx, c, principal, currentRoleBindings, desiredRoleBindings); err != nil {
+			return diag.Errorf("error updating Principal Role Bindings %q: %s", d.Id(), createDescriptiveError(err))
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Finished updating Principal Role Bindings %q", d.Id()), map[string]interface{}{principalRoleBindingsLoggingKey: d.Id()})
+	}
+	return principalRoleBindingsRead(ctx, d, meta)
+}
+
+func principalRoleBindingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Principal Role Bindings %q", d.Id()), map[string]interface{}{principalRoleBindingsLoggingKey: d.Id()})
+	c := meta.(*Client)
+
+	principal := d.Get(paramPrincipal).(string)
+	currentRoleBindings := extractPrincipalRoleBindings(d.Get(paramRoleBinding).(*schema.Set))
+
+	// Deleting the resource means the principal is left without any Role Bindings on the declared CRN patterns
+	if err := reconcileRoleBindingsForPrincipal(ctx, c, principal, currentRoleBindings, nil); err != nil {
+		return diag.Errorf("error deleting Principal Role Bindings %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Principal Role Bindings %q", d.Id()), map[string]interface{}{principalRoleBindingsLoggingKey: d.Id()})
+
+	return nil
+}
+
+// Creates desired Role Bindings that are missing and deletes Role Bindings of a principal that are not desired.
+// IAM V2 API doesn't allow listing Role Bindings without a CRN pattern, so only the CRN patterns of
+// current and desired Role Bindings are considered.
+func reconcileRoleBindingsForPrincipal(ctx context.Context, c *Client, principal string, currentRoleBindings, desiredRoleBindings []principalRoleBinding) error {
+	crnPatterns := principalRoleBindingCrnPatterns(append(append([]principalRoleBinding{}, currentRoleBindings...), desiredRoleBindings...))
+	remoteRoleBindings, err := loadRoleBindingsForPrincipal(ctx, c, principal, crnPatterns)
+	if err != nil {
+		return err
+	}
+	roleBindingsToCreate, roleBindingsToDelete := diffRoleBindings(remoteRoleBindings, desiredRoleBindings)
+
+	// Role Bindings that have been created or deleted before a failure are not created or deleted again
+	// on the next run since the remote Role Bindings are diffed again
+	for i, roleBinding := range roleBindingsToCreate {
+		tflog.Debug(ctx, fmt.Sprintf("Creating Role Binding %q on %q for %q (%d of %d)", roleBinding[0], roleBinding[1], principal, i+1, len(roleBindingsToCreate)))
+		createRoleBindingRequest := mds.NewIamV2RoleBinding()
+		createRoleBindingRequest.SetPrincipal(principal)
+		createRoleBindingRequest.SetRoleName(roleBinding[0])
+		createRoleBindingRequest.SetCrnPattern(roleBinding[1])
+		if _, _, err := executeRoleBindingCreate(c.mdsApiContext(ctx), c, createRoleBindingRequest); err != nil {
+			return fmt.Errorf("error creating Role Binding %q on %q (%d of %d Role Bindings have been created): %s", roleBinding[0], roleBinding[1], i, len(roleBindingsToCreate), createDescriptiveError(err))
+		}
+	}
+	for i, roleBinding := range roleBindingsToDelete {
+		tflog.Debug(ctx, fmt.Sprintf("Deleting Role Binding %q (%d of %d)", roleBinding.GetId(), i+1, len(roleBindingsToDelete)), map[string]interface{}{roleBindingLoggingKey: roleBinding.GetId()})
+		if _, err := c.mdsClient.RoleBindingsIamV2Api.DeleteIamV2RoleBinding(c.mdsApiContext(ctx), roleBinding.GetId()).Execute(); err != nil {
+			return fmt.Errorf("error deleting Role Binding %q (%d of %d Role Bindings have been deleted): %s", roleBinding.GetId(), i, len(roleBindingsToDelete), createDescriptiveError(err))
+		}
+	}
+	if len(roleBindingsToCreate) > 0 {
+		time.Sleep(rbacWaitAfterCreateToSync)
+	}
+	return nil
+}
+
+// Returns Role Bindings that are desired but don't exist yet and Role Bindings that exist but are not desired
+func diffRoleBindings(remoteRoleBindings []mds.IamV2RoleBinding, desiredRoleBindings []principalRoleBinding) ([]principalRoleBinding, []mds.IamV2RoleBinding) {
+	remoteRoleBindingKeys := make(map[principalRoleBinding]bool)
+	for _, roleBinding := range remoteRoleBindings {
+		remoteRoleBindingKeys[principalRoleBinding{roleBinding.GetRoleName(), roleBinding.GetCrnPattern()}] = true
+	}
+	desiredRoleBindingKeys := make(map[principalRoleBinding]bool)
+	for _, roleBinding := range desiredRoleBindings {
+		desiredRoleBindingKeys[roleBinding] = true
+	}
+	var roleBindingsToCreate []principalRoleBinding
+	var roleBindingsToDelete []mds.IamV2RoleBinding
+	for _, roleBinding := range desiredRoleBindings {
+		if !remoteRoleBindingKeys[roleBinding] {
+			roleBindingsToCreate = append(roleBindingsToCreate, roleBinding)
+			// Avoid creating duplicates
+			remoteRoleBindingKeys[roleBinding] = true
+		}
+	}
+	for _, roleBinding := range remoteRoleBindings {
+		if !desiredRoleBindingKeys[principalRoleBinding{roleBinding.GetRoleName(), roleBinding.GetCrnPattern()}] {
+			roleBindingsToDelete = append(roleBindingsToDelete, roleBinding)
+		}
+	}
+	return roleBindingsToCreate, roleBindingsToDelete
+}
+
+// Lists Role Bindings of a principal whose CRN pattern exactly matches one of the given CRN patterns
+func loadRoleBindingsForPrincipal(ctx context.Context, c *Client, principal string, crnPatterns []string) ([]mds.IamV2RoleBinding, error) {
+	roleBindings := make([]mds.IamV2RoleBinding, 0)
+	for _, crnPattern := range crnPatterns {
+		allRoleBindingsAreCollected := false
+		pageToken := ""
+		for !allRoleBindingsAreCollected {
+			roleBindingsPageList, _, err := executeListRoleBindings(ctx, c, principal, crnPattern, pageToken)
+			if err != nil {
+				return nil, fmt.Errorf("error reading Role Bindings for %q on %q: %s", principal, crnPattern, createDescriptiveError(err))
+			}
+			// crn_pattern filter performs a partial search
+			for _, roleBinding := range roleBindingsPageList.GetData() {
+				if roleBinding.GetPrincipal() == principal && roleBinding.GetCrnPattern() == crnPattern {
+					roleBindings = append(roleBindings, roleBinding)
+				}
+			}
+
+			// nextPageUrlStringNullable is nil for the last page
+			nextPageUrlStringNullable := roleBindingsPageList.GetMetadata().Next
+
+			if nextPageUrlStringNullable.IsSet() {
+				nextPageUrlString := *nextPageUrlStringNullable.Get()
+				if nextPageUrlString == "" {
+					allRoleBindingsAreCollected = true
+				} else {
+					pageToken, err = extractPageToken(nextPageUrlString)
+					if err != nil {
+						return nil, fmt.Errorf("error reading Role Bindings for %q on %q: %s", principal, crnPattern, createDescriptiveError(err))
+					}
+				}
+			} else {
+				allRoleBindingsAreCollected = true
+			}
+		}
+	}
+	return roleBindings, nil
+}
+
+func executeListRoleBindings(ctx context.Context, c *Client, principal, crnPattern, pageToken string) (mds.IamV2RoleBindingList, *http.Response, error) {
+	req := c.mdsClient.RoleBindingsIamV2Api.ListIamV2RoleBindings(c.mdsApiContext(ctx)).Principal(principal).CrnPattern(crnPattern).PageSize(listRoleBindingsPageSize)
+	if pageToken != "" {
+		req = req.PageToken(pageToken)
+	}
+	return req.Execute()
+}
+
+func extractPrincipalRoleBindings(entries *schema.Set) []principalRoleBinding {
+	roleBindings := make([]principalRoleBinding, 0, entries.Len())
+	for _, entry := range entries.List() {
+		entryMap := entry.(map[string]interface{})
+		roleBindings = append(roleBindings, principalRoleBinding{entryMap[paramRoleName].(string), entryMap[paramCrnPattern].(string)})
+	}
+	return roleBindings
+}
+
+// Returns sorted unique CRN patterns of the given Role Bindings
+func principalRoleBindingCrnPatterns(roleBindings []principalRoleBinding) []string {
+	uniqueCrnPatterns := make(map[string]bool)
+	crnPatterns := make([]string, 0)
+	for _, roleBinding := range roleBindings {
+		if !uniqueCrnPatterns[roleBinding[1]] {
+			uniqueCrnPatterns[roleBinding[1]] = true
+			crnPatterns = append(crnPatterns, roleBinding[1])
+		}
+	}
+	sort.Strings(crnPatterns)
+	return crnPatterns
+}
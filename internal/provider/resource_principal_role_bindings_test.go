@@ -0,0 +1,48 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	mds "github.com/confluentinc/ccloud-sdk-go-v2/mds/v2"
+	"reflect"
+	"testing"
+)
+
+func TestDiffRoleBindings(t *testing.T) {
+	newRoleBinding := func(id, roleName, crnPattern string) mds.IamV2RoleBinding {
+		return mds.IamV2RoleBinding{Id: mds.PtrString(id), RoleName: mds.PtrString(roleName), CrnPattern: mds.PtrString(crnPattern)}
+	}
+	remoteRoleBindings := []mds.IamV2RoleBinding{
+		newRoleBinding("rb-1", "DeveloperRead", "crn://confluent.cloud/kafka=lkc-abc123/topic=orders"),
+		newRoleBinding("rb-2", "DeveloperWrite", "crn://confluent.cloud/kafka=lkc-abc123/topic=orders"),
+	}
+	desiredRoleBindings := []principalRoleBinding{
+		{"DeveloperRead", "crn://confluent.cloud/kafka=lkc-abc123/topic=orders"},
+		{"DeveloperRead", "crn://confluent.cloud/kafka=lkc-abc123/topic=payments"},
+		{"DeveloperRead", "crn://confluent.cloud/kafka=lkc-abc123/topic=payments"},
+	}
+	roleBindingsToCreate, roleBindingsToDelete := diffRoleBindings(remoteRoleBindings, desiredRoleBindings)
+	expectedRoleBindingsToCreate := []principalRoleBinding{{"DeveloperRead", "crn://confluent.cloud/kafka=lkc-abc123/topic=payments"}}
+	if !reflect.DeepEqual(roleBindingsToCreate, expectedRoleBindingsToCreate) {
+		t.Fatalf("Unexpected Role Bindings to create: expected %v, got %v", expectedRoleBindingsToCreate, roleBindingsToCreate)
+	}
+	if len(roleBindingsToDelete) != 1 || roleBindingsToDelete[0].GetId() != "rb-2" {
+		t.Fatalf("Unexpected Role Bindings to delete: expected [rb-2], got %v", roleBindingsToDelete)
+	}
+	expectedCrnPatterns := []string{"crn://confluent.cloud/kafka=lkc-abc123/topic=orders", "crn://confluent.cloud/kafka=lkc-abc123/topic=payments"}
+	if crnPatterns := principalRoleBindingCrnPatterns(desiredRoleBindings); !reflect.DeepEqual(crnPatterns, expectedCrnPatterns) {
+		t.Fatalf("Unexpected CRN patterns: expected %v, got %v", expectedCrnPatterns, crnPatterns)
+	}
+}
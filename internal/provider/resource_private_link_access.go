@@ -59,10 +59,12 @@ func privateLinkAccessResource() *schema.Resource {
 			paramAws:         awsSchema(),
 			paramAzure:       azureSchema(),
 			paramNetwork:     requiredNetworkSchema(),
-			paramEnvironment: environmentSchema(),
+			paramEnvironment: environmentSchemaWithDefault(),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(networkingAPICreateTimeout),
+			Read:   schema.DefaultTimeout(defaultResourceTimeout),
+			Update: schema.DefaultTimeout(defaultResourceTimeout),
 			Delete: schema.DefaultTimeout(networkingAPIDeleteTimeout),
 		},
 	}
@@ -127,7 +129,7 @@ func privateLinkAccessCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 	d.SetId(createdPrivateLinkAccess.GetId())
 
-	if err := waitForPrivateLinkAccessToProvision(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForPrivateLinkAccessToProvision(c.netApiContext(ctx), c, environmentId, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("error waiting for Private Link Access %q to provision: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -233,7 +235,7 @@ func privateLinkAccessDelete(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("error deleting Private Link Access %q: %s", d.Id(), createDescriptiveError(err))
 	}
 
-	if err := waitForPrivateLinkAccessToBeDeleted(c.netApiContext(ctx), c, environmentId, d.Id()); err != nil {
+	if err := waitForPrivateLinkAccessToBeDeleted(c.netApiContext(ctx), c, environmentId, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return diag.Errorf("error waiting for Private Link Access %q to be deleted: %s", d.Id(), createDescriptiveError(err))
 	}
 
@@ -0,0 +1,309 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	paramOtlpEndpoint = "otlp_endpoint"
+	paramOtlpHeaders  = "otlp_headers"
+
+	// OTLP/HTTP exporters append it to OTEL_EXPORTER_OTLP_ENDPOINT
+	otlpTracesPath      = "/v1/traces"
+	otlpServiceName     = "terraform-provider-confluent"
+	otlpExportTimeout   = 10 * time.Second
+	tracingLoggingKey   = "otlp_endpoint"
+	otlpSpanKindServer  = 2
+	otlpSpanKindClient  = 3
+	otlpStatusCodeError = 2
+
+	attributeResourceType   = "terraform.resource_type"
+	attributeOperation      = "terraform.operation"
+	attributeResourceId     = "terraform.resource_id"
+	attributeHttpMethod     = "http.method"
+	attributeHttpUrl        = "http.url"
+	attributeHttpStatus     = "http.status_code"
+	attributeRequestId      = "http.request_id"
+	attributeServiceName    = "service.name"
+	attributeServiceVersion = "service.version"
+)
+
+// Exports spans of provider operations and the HTTP requests they send to an OpenTelemetry collector
+// using OTLP/HTTP with JSON encoding, which doesn't require OpenTelemetry SDKs
+type tracer struct {
+	tracesUrl       string
+	headers         map[string]string
+	httpClient      *http.Client
+	providerVersion string
+}
+
+type span struct {
+	traceId      string
+	spanId       string
+	parentSpanId string
+	name         string
+	kind         int
+	start        time.Time
+	end          time.Time
+	attributes   map[string]interface{}
+	errorMessage string
+	isError      bool
+
+	// Set for the spans of provider operations only
+	mu       sync.Mutex
+	children []*span
+}
+
+type spanContextKey struct{}
+
+func newTracer(httpClient *http.Client, endpoint string, headers map[string]interface{}, providerVersion string) *tracer {
+	if endpoint == "" {
+		return nil
+	}
+	tracerHeaders := make(map[string]string, len(headers))
+	for name, value := range headers {
+		tracerHeaders[name] = value.(string)
+	}
+	return &tracer{
+		tracesUrl:       strings.TrimSuffix(endpoint, "/") + otlpTracesPath,
+		headers:         tracerHeaders,
+		httpClient:      httpClient,
+		providerVersion: providerVersion,
+	}
+}
+
+func randomHexId(length int) string {
+	id := make([]byte, length)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+func newSpan(parent *span, name string, kind int, attributes map[string]interface{}) *span {
+	s := &span{spanId: randomHexId(8), name: name, kind: kind, start: time.Now(), attributes: attributes}
+	if parent != nil {
+		s.traceId = parent.traceId
+		s.parentSpanId = parent.spanId
+	} else {
+		s.traceId = randomHexId(16)
+	}
+	return s
+}
+
+func (s *span) addChild(child *span) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.children = append(s.children, child)
+}
+
+func spanFromContext(ctx context.Context) *span {
+	s, _ := ctx.Value(spanContextKey{}).(*span)
+	return s
+}
+
+// Wraps CRUD functions of every resource and data source so that each of them is traced as a span
+// whose children are the HTTP requests it sends
+func addTracing(resources map[string]*schema.Resource) {
+	for resourceType, r := range resources {
+		if r.CreateContext != nil {
+			r.CreateContext = traceOperation(resourceType, "create", r.CreateContext)
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = traceOperation(resourceType, "read", r.ReadContext)
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = traceOperation(resourceType, "update", r.UpdateContext)
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = traceOperation(resourceType, "delete", r.DeleteContext)
+		}
+	}
+}
+
+func traceOperation(resourceType, operationName string, operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client, ok := meta.(*Client)
+		if !ok || client.tracer == nil {
+			return operation(ctx, d, meta)
+		}
+		operationSpan := newSpan(nil, fmt.Sprintf("%s.%s", resourceType, operationName), otlpSpanKindServer, map[string]interface{}{
+			attributeResourceType: resourceType,
+			attributeOperation:    operationName,
+		})
+		diags := operation(context.WithValue(ctx, spanContextKey{}, operationSpan), d, meta)
+		operationSpan.end = time.Now()
+		if id := d.Id(); id != "" {
+			operationSpan.attributes[attributeResourceId] = id
+		}
+		for _, diagnostic := range diags {
+			if diagnostic.Severity == diag.Error {
+				operationSpan.isError = true
+				operationSpan.errorMessage = diagnostic.Summary
+				break
+			}
+		}
+		// Tracing must never fail an operation, and the operation context might be canceled already
+		exportCtx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+		defer cancel()
+		if err := client.tracer.export(exportCtx, operationSpan); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error exporting spans: %s", err), map[string]interface{}{tracingLoggingKey: client.tracer.tracesUrl})
+		}
+		return diags
+	}
+}
+
+// Records a span for every HTTP request attempt that is sent while a provider operation is traced
+type tracingRoundTripper struct {
+	Transport http.RoundTripper
+}
+
+func (t *tracingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var transport http.RoundTripper
+	if t.Transport != nil {
+		transport = t.Transport
+	} else {
+		transport = http.DefaultTransport
+	}
+
+	parent := spanFromContext(req.Context())
+	if parent == nil {
+		return transport.RoundTrip(req)
+	}
+	requestSpan := newSpan(parent, requestTimingsEndpoint(req), otlpSpanKindClient, map[string]interface{}{
+		attributeResourceType: parent.attributes[attributeResourceType],
+		attributeHttpMethod:   req.Method,
+		// Query parameters are omitted since they might contain page tokens
+		attributeHttpUrl: fmt.Sprintf("%s://%s%s", req.URL.Scheme, req.URL.Host, req.URL.Path),
+	})
+	resp, err := transport.RoundTrip(req)
+	requestSpan.end = time.Now()
+	if err != nil {
+		requestSpan.isError = true
+		requestSpan.errorMessage = err.Error()
+	} else {
+		requestSpan.attributes[attributeHttpStatus] = resp.StatusCode
+		if requestId := resp.Header.Get(requestIdHeader); requestId != "" {
+			requestSpan.attributes[attributeRequestId] = requestId
+		}
+		if resp.StatusCode >= http.StatusBadRequest {
+			requestSpan.isError = true
+			requestSpan.errorMessage = resp.Status
+		}
+	}
+	parent.addChild(requestSpan)
+
+	return resp, err
+}
+
+func (t *tracer) export(ctx context.Context, operationSpan *span) error {
+	operationSpan.mu.Lock()
+	spans := append([]*span{operationSpan}, operationSpan.children...)
+	operationSpan.mu.Unlock()
+
+	body, err := json.Marshal(t.otlpRequest(spans))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.tracesUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("the OpenTelemetry collector returned %s", resp.Status)
+	}
+	return nil
+}
+
+// Builds an ExportTraceServiceRequest in the JSON encoding of OTLP
+func (t *tracer) otlpRequest(spans []*span) map[string]interface{} {
+	otlpSpans := make([]map[string]interface{}, 0, len(spans))
+	for _, s := range spans {
+		otlpSpan := map[string]interface{}{
+			"traceId":           s.traceId,
+			"spanId":            s.spanId,
+			"name":              s.name,
+			"kind":              s.kind,
+			"startTimeUnixNano": strconv.FormatInt(s.start.UnixNano(), 10),
+			"endTimeUnixNano":   strconv.FormatInt(s.end.UnixNano(), 10),
+			"attributes":        otlpAttributes(s.attributes),
+		}
+		if s.parentSpanId != "" {
+			otlpSpan["parentSpanId"] = s.parentSpanId
+		}
+		if s.isError {
+			otlpSpan["status"] = map[string]interface{}{"code": otlpStatusCodeError, "message": s.errorMessage}
+		}
+		otlpSpans = append(otlpSpans, otlpSpan)
+	}
+	return map[string]interface{}{
+		"resourceSpans": []interface{}{map[string]interface{}{
+			"resource": map[string]interface{}{
+				"attributes": otlpAttributes(map[string]interface{}{
+					attributeServiceName:    otlpServiceName,
+					attributeServiceVersion: t.providerVersion,
+				}),
+			},
+			"scopeSpans": []interface{}{map[string]interface{}{
+				"scope": map[string]interface{}{"name": otlpServiceName, "version": t.providerVersion},
+				"spans": otlpSpans,
+			}},
+		}},
+	}
+}
+
+func otlpAttributes(attributes map[string]interface{}) []interface{} {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	otlpAttributes := make([]interface{}, 0, len(attributes))
+	for _, key := range keys {
+		var otlpValue map[string]interface{}
+		switch v := attributes[key].(type) {
+		case int:
+			// OTLP encodes 64-bit integers as strings in JSON
+			otlpValue = map[string]interface{}{"intValue": strconv.Itoa(v)}
+		default:
+			otlpValue = map[string]interface{}{"stringValue": fmt.Sprintf("%v", v)}
+		}
+		otlpAttributes = append(otlpAttributes, map[string]interface{}{"key": key, "value": otlpValue})
+	}
+	return otlpAttributes
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	cmk "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTracing(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIdHeader, "req-123")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer api.Close()
+	var exported map[string][]struct {
+		ScopeSpans []struct {
+			Spans []struct {
+				TraceId      string `json:"traceId"`
+				SpanId       string `json:"spanId"`
+				ParentSpanId string `json:"parentSpanId"`
+				Name         string `json:"name"`
+			} `json:"spans"`
+		} `json:"scopeSpans"`
+	}
+	var exportedPath, exportedHeader string
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exportedPath = r.URL.Path
+		exportedHeader = r.Header.Get("X-Api-Key")
+		_ = json.NewDecoder(r.Body).Decode(&exported)
+	}))
+	defer collector.Close()
+
+	cmkCfg := cmk.NewConfiguration()
+	cmkCfg.Servers[0].URL = api.URL
+	cmkCfg.HTTPClient = createRetryableHttpClient(defaultHttpClientSettings())
+	kafkaRestCfg := kafkarestv3.NewConfiguration()
+	kafkaRestCfg.BasePath = api.URL
+	kafkaRestCfg.HTTPClient = createRetryableHttpClient(defaultHttpClientSettings())
+	kafkaRestClient := &KafkaRestClient{apiClient: kafkarestv3.NewAPIClient(kafkaRestCfg), clusterId: "lkc-abc123", clusterApiKey: "KAFKAAPIKEY12345", clusterApiSecret: "secret"}
+	client := &Client{
+		tracer:         newTracer(createRetryableHttpClientWithExponentialBackoff(), collector.URL, map[string]interface{}{"X-Api-Key": "secret"}, "1.2.3"),
+		cmkClient:      cmk.NewAPIClient(cmkCfg),
+		cloudApiKey:    "ABCDEFGHIJKLMNOP",
+		cloudApiSecret: "secret",
+	}
+	// Requests are sent through the SDK clients, the same way resources send them
+	read := traceOperation("confluent_kafka_topic", "read", func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if _, _, err := client.cmkClient.ClustersCmkV2Api.GetCmkV2Cluster(client.cmkApiContext(ctx), "lkc-abc123").Environment("env-abc123").Execute(); err != nil {
+			return diag.FromErr(err)
+		}
+		if _, _, err := kafkaRestClient.apiClient.TopicV3Api.GetKafkaV3Topic(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId, "orders"); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId("lkc-abc123/orders")
+		return nil
+	})
+	if diags := read(context.Background(), kafkaTopicResource().TestResourceData(), client); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if exportedPath != otlpTracesPath || exportedHeader != "secret" {
+		t.Fatalf("Expected spans to be exported to %q with the configured headers, got %q", otlpTracesPath, exportedPath)
+	}
+	spans := exported["resourceSpans"][0].ScopeSpans[0].Spans
+	if len(spans) != 3 {
+		t.Fatalf("Expected an operation span and 2 request spans, got %d spans", len(spans))
+	}
+	if spans[0].Name != "confluent_kafka_topic.read" || spans[1].Name != "GET /cmk/v2/clusters/{id}" || spans[2].Name != "GET /kafka/v3/clusters/{id}/topics/{id}" {
+		t.Fatalf("Unexpected span names %q, %q and %q", spans[0].Name, spans[1].Name, spans[2].Name)
+	}
+	for _, requestSpan := range spans[1:] {
+		if requestSpan.TraceId != spans[0].TraceId || requestSpan.ParentSpanId != spans[0].SpanId {
+			t.Fatalf("Expected the request span %q to be a child of the operation span", requestSpan.Name)
+		}
+	}
+}
@@ -23,7 +23,7 @@ import (
 	"time"
 )
 
-func waitForCreatedKafkaApiKeyToSync(ctx context.Context, c *KafkaRestClient) error {
+func waitForCreatedKafkaApiKeyToSync(ctx context.Context, c *KafkaRestClient, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateInProgress},
 		Target:  []string{stateDone},
@@ -31,7 +31,7 @@ func waitForCreatedKafkaApiKeyToSync(ctx context.Context, c *KafkaRestClient) er
 		// Default timeout for a resource
 		// https://www.terraform.io/plugin/sdkv2/resources/retries-and-customizable-timeouts
 		// Based on the tests, Kafka API Key takes about 2 minutes to sync
-		Timeout:      20 * time.Minute,
+		Timeout:      timeout,
 		Delay:        1 * time.Minute,
 		PollInterval: 1 * time.Minute,
 		// Expects 2x http.StatusOK before exiting which adds PollInterval to the total time it takes to sync an API Key
@@ -46,7 +46,7 @@ func waitForCreatedKafkaApiKeyToSync(ctx context.Context, c *KafkaRestClient) er
 	return nil
 }
 
-func waitForCreatedCloudApiKeyToSync(ctx context.Context, c *Client, cloudApiKey, cloudApiSecret string) error {
+func waitForCreatedCloudApiKeyToSync(ctx context.Context, c *Client, cloudApiKey, cloudApiSecret string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateInProgress},
 		Target:  []string{stateDone},
@@ -54,7 +54,7 @@ func waitForCreatedCloudApiKeyToSync(ctx context.Context, c *Client, cloudApiKey
 		// Default timeout for a resource
 		// https://www.terraform.io/plugin/sdkv2/resources/retries-and-customizable-timeouts
 		// Based on the tests, Cloud API Key takes about 10 seconds to sync (or even faster)
-		Timeout:      20 * time.Minute,
+		Timeout:      timeout,
 		Delay:        15 * time.Second,
 		PollInterval: 1 * time.Minute,
 	}
@@ -66,13 +66,13 @@ func waitForCreatedCloudApiKeyToSync(ctx context.Context, c *Client, cloudApiKey
 	return nil
 }
 
-func waitForKafkaClusterToProvision(ctx context.Context, c *Client, environmentId, clusterId, clusterType string) error {
+func waitForKafkaClusterToProvision(ctx context.Context, c *Client, environmentId, clusterId string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateProvisioned},
 		Refresh: kafkaClusterProvisionStatus(c.cmkApiContext(ctx), c, environmentId, clusterId),
 		// https://docs.confluent.io/cloud/current/clusters/cluster-types.html#provisioning-time
-		Timeout:      getTimeoutFor(clusterType),
+		Timeout:      timeout,
 		Delay:        5 * time.Second,
 		PollInterval: 1 * time.Minute,
 	}
@@ -84,12 +84,12 @@ func waitForKafkaClusterToProvision(ctx context.Context, c *Client, environmentI
 	return nil
 }
 
-func waitForPrivateLinkAccessToProvision(ctx context.Context, c *Client, environmentId, privateLinkAccessId string) error {
+func waitForPrivateLinkAccessToProvision(ctx context.Context, c *Client, environmentId, privateLinkAccessId string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{stateProvisioning},
 		Target:       []string{stateReady},
 		Refresh:      privateLinkAccessProvisionStatus(c.netApiContext(ctx), c, environmentId, privateLinkAccessId),
-		Timeout:      networkingAPICreateTimeout,
+		Timeout:      timeout,
 		Delay:        5 * time.Second,
 		PollInterval: 1 * time.Minute,
 	}
@@ -101,12 +101,12 @@ func waitForPrivateLinkAccessToProvision(ctx context.Context, c *Client, environ
 	return nil
 }
 
-func waitForNetworkToProvision(ctx context.Context, c *Client, environmentId, networkId string) error {
+func waitForNetworkToProvision(ctx context.Context, c *Client, environmentId, networkId string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateReady},
 		Refresh: networkProvisionStatus(c.netApiContext(ctx), c, environmentId, networkId),
-		Timeout: networkingAPICreateTimeout,
+		Timeout: timeout,
 		// TODO: increase delay
 		Delay:        5 * time.Second,
 		PollInterval: 1 * time.Minute,
@@ -119,13 +119,13 @@ func waitForNetworkToProvision(ctx context.Context, c *Client, environmentId, ne
 	return nil
 }
 
-func waitForConnectorToProvision(ctx context.Context, c *Client, displayName, environmentId, clusterId string) error {
+func waitForConnectorToProvision(ctx context.Context, c *Client, displayName, environmentId, clusterId string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		// Allow PROVISIONING -> DEGRADED -> RUNNING transition
 		Pending:      []string{stateProvisioning, stateDegraded},
 		Target:       []string{stateRunning},
 		Refresh:      connectorProvisionStatus(c.connectApiContext(ctx), c, displayName, environmentId, clusterId),
-		Timeout:      connectAPICreateTimeout,
+		Timeout:      timeout,
 		Delay:        1 * time.Minute,
 		PollInterval: 1 * time.Minute,
 	}
@@ -137,12 +137,12 @@ func waitForConnectorToProvision(ctx context.Context, c *Client, displayName, en
 	return nil
 }
 
-func waitForConnectorToChangeStatus(ctx context.Context, c *Client, displayName, environmentId, clusterId, currentStatus, targetStatus string) error {
+func waitForConnectorToChangeStatus(ctx context.Context, c *Client, displayName, environmentId, clusterId, currentStatus, targetStatus string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{currentStatus},
 		Target:       []string{targetStatus},
 		Refresh:      connectorUpdateStatus(c.connectApiContext(ctx), c, displayName, environmentId, clusterId),
-		Timeout:      1 * time.Hour,
+		Timeout:      timeout,
 		Delay:        30 * time.Second,
 		PollInterval: 1 * time.Minute,
 	}
@@ -154,12 +154,12 @@ func waitForConnectorToChangeStatus(ctx context.Context, c *Client, displayName,
 	return nil
 }
 
-func waitForPeeringToProvision(ctx context.Context, c *Client, environmentId, peeringId string) error {
+func waitForPeeringToProvision(ctx context.Context, c *Client, environmentId, peeringId string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateProvisioning},
 		Target:  []string{stateReady, statePendingAccept},
 		Refresh: peeringProvisionStatus(c.netApiContext(ctx), c, environmentId, peeringId),
-		Timeout: networkingAPICreateTimeout,
+		Timeout: timeout,
 		// TODO: increase delay
 		Delay:        5 * time.Second,
 		PollInterval: 1 * time.Minute,
@@ -172,13 +172,13 @@ func waitForPeeringToProvision(ctx context.Context, c *Client, environmentId, pe
 	return nil
 }
 
-func waitForKafkaClusterCkuUpdateToComplete(ctx context.Context, c *Client, environmentId, clusterId string, cku int32) error {
+func waitForKafkaClusterCkuUpdateToComplete(ctx context.Context, c *Client, environmentId, clusterId string, cku int32, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{stateInProgress},
 		Target:  []string{stateDone},
 		Refresh: kafkaClusterCkuUpdateStatus(c.cmkApiContext(ctx), c, environmentId, clusterId, cku),
 		// https://docs.confluent.io/cloud/current/clusters/cluster-types.html#resizing-time
-		Timeout:      getTimeoutFor(kafkaClusterTypeDedicated),
+		Timeout:      timeout,
 		Delay:        5 * time.Second,
 		PollInterval: 1 * time.Minute,
 	}
@@ -190,12 +190,12 @@ func waitForKafkaClusterCkuUpdateToComplete(ctx context.Context, c *Client, envi
 	return nil
 }
 
-func waitForPrivateLinkAccessToBeDeleted(ctx context.Context, c *Client, environmentId, privateLinkAccessId string) error {
+func waitForPrivateLinkAccessToBeDeleted(ctx context.Context, c *Client, environmentId, privateLinkAccessId string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{stateInProgress},
 		Target:       []string{stateDone},
 		Refresh:      privateLinkAccessDeleteStatus(c.netApiContext(ctx), c, environmentId, privateLinkAccessId),
-		Timeout:      networkingAPIDeleteTimeout,
+		Timeout:      timeout,
 		Delay:        1 * time.Minute,
 		PollInterval: 1 * time.Minute,
 	}
@@ -207,12 +207,12 @@ func waitForPrivateLinkAccessToBeDeleted(ctx context.Context, c *Client, environ
 	return nil
 }
 
-func waitForPeeringToBeDeleted(ctx context.Context, c *Client, environmentId, peeringId string) error {
+func waitForPeeringToBeDeleted(ctx context.Context, c *Client, environmentId, peeringId string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{stateInProgress},
 		Target:       []string{stateDone},
 		Refresh:      peeringDeleteStatus(c.netApiContext(ctx), c, environmentId, peeringId),
-		Timeout:      networkingAPIDeleteTimeout,
+		Timeout:      timeout,
 		Delay:        1 * time.Minute,
 		PollInterval: 1 * time.Minute,
 	}
@@ -224,12 +224,12 @@ func waitForPeeringToBeDeleted(ctx context.Context, c *Client, environmentId, pe
 	return nil
 }
 
-func waitForKafkaTopicToBeDeleted(ctx context.Context, c *KafkaRestClient, topicName string) error {
+func waitForKafkaTopicToBeDeleted(ctx context.Context, c *KafkaRestClient, topicName string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{stateInProgress},
 		Target:       []string{stateDone},
 		Refresh:      kafkaTopicDeleteStatus(c.apiContext(ctx), c, topicName),
-		Timeout:      1 * time.Hour,
+		Timeout:      timeout,
 		Delay:        10 * time.Second,
 		PollInterval: 1 * time.Minute,
 	}
@@ -242,6 +242,75 @@ func waitForKafkaTopicToBeDeleted(ctx context.Context, c *KafkaRestClient, topic
 	return nil
 }
 
+func waitForCreatedKafkaAclToSync(ctx context.Context, c *KafkaRestClient, acl Acl, principalWithIntegerId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{stateInProgress},
+		Target:  []string{stateDone},
+		Refresh: kafkaAclCreateStatus(c.apiContext(ctx), c, acl, principalWithIntegerId),
+		// Based on the tests, Kafka ACLs take up to a few seconds to become readable after they were created
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+
+	aclId := createKafkaAclId(c.clusterId, acl)
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for Kafka ACLs %q to sync", aclId), map[string]interface{}{kafkaAclLoggingKey: aclId})
+	if _, err := stateConf.WaitForStateContext(c.apiContext(ctx)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func waitForKafkaAclToBeDeleted(ctx context.Context, c *KafkaRestClient, acl Acl, principalWithIntegerId string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{stateInProgress},
+		Target:       []string{stateDone},
+		Refresh:      kafkaAclDeleteStatus(c.apiContext(ctx), c, acl, principalWithIntegerId),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+
+	aclId := createKafkaAclId(c.clusterId, acl)
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for Kafka ACLs %q to be deleted", aclId), map[string]interface{}{kafkaAclLoggingKey: aclId})
+	if _, err := stateConf.WaitForStateContext(c.apiContext(ctx)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func kafkaAclCreateStatus(ctx context.Context, c *KafkaRestClient, acl Acl, principalWithIntegerId string) resource.StateRefreshFunc {
+	return func() (result interface{}, s string, err error) {
+		remoteAcls, _, err := executeKafkaAclRead(ctx, c, createGetKafkaV3AclsOpts(acl, principalWithIntegerId))
+		aclId := createKafkaAclId(c.clusterId, acl)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error reading Kafka ACLs %q: %s", aclId, createDescriptiveError(err)), map[string]interface{}{kafkaAclLoggingKey: aclId})
+			return nil, stateFailed, err
+		}
+		if len(remoteAcls.Data) == 0 {
+			tflog.Debug(ctx, fmt.Sprintf("Waiting for Kafka ACLs %q to sync: no Kafka ACLs were matched yet", aclId), map[string]interface{}{kafkaAclLoggingKey: aclId})
+			return remoteAcls, stateInProgress, nil
+		}
+		return remoteAcls, stateDone, nil
+	}
+}
+
+func kafkaAclDeleteStatus(ctx context.Context, c *KafkaRestClient, acl Acl, principalWithIntegerId string) resource.StateRefreshFunc {
+	return func() (result interface{}, s string, err error) {
+		remoteAcls, _, err := executeKafkaAclRead(ctx, c, createGetKafkaV3AclsOpts(acl, principalWithIntegerId))
+		aclId := createKafkaAclId(c.clusterId, acl)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error reading Kafka ACLs %q: %s", aclId, createDescriptiveError(err)), map[string]interface{}{kafkaAclLoggingKey: aclId})
+			return nil, stateFailed, err
+		}
+		if len(remoteAcls.Data) > 0 {
+			tflog.Debug(ctx, fmt.Sprintf("Waiting for Kafka ACLs %q to be deleted: %d Kafka ACLs are still matched", aclId, len(remoteAcls.Data)), map[string]interface{}{kafkaAclLoggingKey: aclId})
+			return remoteAcls, stateInProgress, nil
+		}
+		return remoteAcls, stateDone, nil
+	}
+}
+
 func kafkaTopicDeleteStatus(ctx context.Context, c *KafkaRestClient, topicName string) resource.StateRefreshFunc {
 	return func() (result interface{}, s string, err error) {
 		kafkaTopic, resp, err := c.apiClient.TopicV3Api.GetKafkaV3Topic(c.apiContext(ctx), c.clusterId, topicName)
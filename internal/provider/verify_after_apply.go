@@ -0,0 +1,96 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"reflect"
+	"sort"
+)
+
+// Wraps create and update functions of every resource so that they re-read the resource
+// and compare it against the configuration once they finish if provider.verify_after_apply is set
+func addVerifyAfterApply(resources map[string]*schema.Resource) {
+	for resourceType, r := range resources {
+		if r.CreateContext != nil {
+			r.CreateContext = verifyAfterApply(resourceType, r, r.CreateContext)
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = verifyAfterApply(resourceType, r, r.UpdateContext)
+		}
+	}
+}
+
+func verifyAfterApply(resourceType string, r *schema.Resource, apply func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if !meta.(*Client).verifyAfterApply {
+			return apply(ctx, d, meta)
+		}
+		// Captured before the apply, since create and update functions overwrite the planned values with the values they read
+		desiredValues := extractVerifiableValues(r, d)
+		diags := apply(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, verifyResourceAfterApply(ctx, resourceType, r, desiredValues, d, meta)...)
+	}
+}
+
+func verifyResourceAfterApply(ctx context.Context, resourceType string, r *schema.Resource, desiredValues map[string]interface{}, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	resourceId := d.Id()
+
+	if diags := r.ReadContext(ctx, d, meta); diags.HasError() {
+		return append(diags, diag.Errorf("error verifying %s %q after apply: could not read the resource", resourceType, resourceId)...)
+	}
+	if d.Id() == "" {
+		return diag.Errorf("error verifying %s %q after apply: the resource was not found", resourceType, resourceId)
+	}
+
+	actualValues := extractVerifiableValues(r, d)
+	var mismatchedAttributes []string
+	for attribute, desiredValue := range desiredValues {
+		if !reflect.DeepEqual(desiredValue, actualValues[attribute]) {
+			mismatchedAttributes = append(mismatchedAttributes, fmt.Sprintf("%s: expected %v, got %v", attribute, desiredValue, actualValues[attribute]))
+		}
+	}
+	if len(mismatchedAttributes) > 0 {
+		sort.Strings(mismatchedAttributes)
+		return diag.Errorf("error verifying %s %q after apply: the remote state doesn't match the configuration: %v", resourceType, resourceId, mismatchedAttributes)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Verified %s %q after apply: the remote state matches the configuration", resourceType, resourceId))
+	return nil
+}
+
+// Returns values of attributes that are set by the configuration only, that is
+// computed attributes and sensitive attributes (that might not be returned by the API) are skipped
+func extractVerifiableValues(r *schema.Resource, d *schema.ResourceData) map[string]interface{} {
+	values := make(map[string]interface{})
+	for attribute, attributeSchema := range r.Schema {
+		if attributeSchema.Computed || attributeSchema.Sensitive {
+			continue
+		}
+		value := d.Get(attribute)
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+		values[attribute] = value
+	}
+	return values
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtractVerifiableValues(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":     {Type: schema.TypeString, Required: true},
+			"id_value": {Type: schema.TypeString, Computed: true},
+			"secret":   {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"tags":     {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":   "orders",
+		"secret": "s3cr3t",
+		"tags":   []interface{}{"a"},
+	})
+	values := extractVerifiableValues(r, d)
+	if len(values) != 2 {
+		t.Fatalf("Unexpected verifiable values: %v", values)
+	}
+	if values["name"] != "orders" {
+		t.Fatalf("Unexpected value of name: %v", values["name"])
+	}
+	if !reflect.DeepEqual(values["tags"], []interface{}{"a"}) {
+		t.Fatalf("Unexpected value of tags: %v", values["tags"])
+	}
+}
+
+func TestVerifyAfterApply(t *testing.T) {
+	remoteName := "orders"
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true},
+		},
+	}
+	r.ReadContext = func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		_ = d.Set("name", remoteName)
+		return nil
+	}
+	// Like every resource, create reads the resource once it's created
+	create := verifyAfterApply("confluent_test", r, func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		d.SetId("test-id")
+		return r.ReadContext(ctx, d, meta)
+	})
+	client := &Client{verifyAfterApply: true}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "orders"})
+	if diags := create(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("Expected no error when the remote state matches the configuration, got %v", diags)
+	}
+
+	remoteName = "payments"
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "orders"})
+	diags := create(context.Background(), d, client)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "name: expected orders, got payments") {
+		t.Fatalf("Expected an error when the remote state doesn't match the configuration, got %v", diags)
+	}
+}
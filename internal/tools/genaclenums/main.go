@@ -0,0 +1,130 @@
+// Copyright 2022 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// genaclenums generates the lists of Kafka ACL resource types, pattern types, operations and permissions
+// from the enums of Kafka REST API v3 SDK so that new values are accepted as soon as the SDK is upgraded.
+//
+// Usage (from internal/provider directory): go run ../tools/genaclenums -output acl_enums_generated.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const kafkaRestSdkPackage = "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+
+// Maps SDK enum types to names of generated variables
+var enums = []struct {
+	sdkFile      string
+	sdkType      string
+	variableName string
+}{
+	{"model_acl_resource_type.go", "AclResourceType", "sdkAclResourceTypes"},
+	{"model_acl_pattern_type.go", "AclPatternType", "sdkAclPatternTypes"},
+	{"model_acl_operation.go", "AclOperation", "sdkAclOperations"},
+	{"model_acl_permission.go", "AclPermission", "sdkAclPermissions"},
+}
+
+func main() {
+	output := flag.String("output", "acl_enums_generated.go", "the file to write the generated code to")
+	flag.Parse()
+
+	sdkDir, err := goList("-f", "{{.Dir}}", kafkaRestSdkPackage)
+	if err != nil {
+		log.Fatalf("error locating %s: %s", kafkaRestSdkPackage, err)
+	}
+	sdkModule, err := goList("-f", "{{with .Module}}{{.Path}} {{.Version}}{{end}}", kafkaRestSdkPackage)
+	if err != nil {
+		log.Fatalf("error locating %s: %s", kafkaRestSdkPackage, err)
+	}
+
+	var code bytes.Buffer
+	fmt.Fprintf(&code, "// Code generated by genaclenums from %s; DO NOT EDIT.\n\npackage provider\n", sdkModule)
+	for _, enum := range enums {
+		values, err := parseEnumValues(filepath.Join(sdkDir, enum.sdkFile), enum.sdkType)
+		if err != nil {
+			log.Fatalf("error parsing %s enum: %s", enum.sdkType, err)
+		}
+		quotedValues := make([]string, len(values))
+		for i, value := range values {
+			quotedValues[i] = strconv.Quote(value)
+		}
+		fmt.Fprintf(&code, "\nvar %s = []string{%s}\n", enum.variableName, strings.Join(quotedValues, ", "))
+	}
+
+	formattedCode, err := format.Source(code.Bytes())
+	if err != nil {
+		log.Fatalf("error formatting generated code: %s", err)
+	}
+	if err := os.WriteFile(*output, formattedCode, 0644); err != nil {
+		log.Fatalf("error writing %s: %s", *output, err)
+	}
+}
+
+func goList(args ...string) (string, error) {
+	out, err := exec.Command("go", append([]string{"list"}, args...)...).Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Returns values of the string constants of the given type in the order they are declared in
+func parseEnumValues(path, typeName string) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var values []string
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			ident, ok := valueSpec.Type.(*ast.Ident)
+			if !ok || ident.Name != typeName {
+				continue
+			}
+			for _, value := range valueSpec.Values {
+				literal, ok := value.(*ast.BasicLit)
+				if !ok || literal.Kind != token.STRING {
+					return nil, fmt.Errorf("unexpected value of a %s constant: %#v", typeName, value)
+				}
+				unquotedValue, err := strconv.Unquote(literal.Value)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, unquotedValue)
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no %s constants were found in %s", typeName, path)
+	}
+	return values, nil
+}